@@ -0,0 +1,61 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attributes
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// redactedSecret is the formatted representation of a non-empty Secret.
+const redactedSecret = "********"
+
+// Secret is a string value, such as a password, that is redacted when formatted by the fmt package.
+// This keeps secrets out of log output and error messages, even when the struct containing them
+// is formatted with %v or %#v. It is encoded to url.Values and JSON with its actual value, but its
+// url.Values key is reported as redacted by values.EncodeRedacted.
+type Secret string
+
+// GetURLValue implements custom encoding of its url.Values value.
+func (s Secret) GetURLValue() interface{} {
+	return string(s)
+}
+
+// RedactURLValue implements values.URLValueRedacter, so that the Secret's encoded value can be
+// masked when displayed, such as in a Diff or DryRun.
+func (s Secret) RedactURLValue() bool {
+	return true
+}
+
+// Value returns the Secret's actual value.
+func (s Secret) Value() string {
+	return string(s)
+}
+
+// Format implements fmt.Formatter. Non-empty Secrets are always formatted as "********", regardless
+// of the verb used. The %q and %#v verbs quote the redacted value.
+func (s Secret) Format(f fmt.State, verb rune) {
+	formatted := ""
+	if s != "" {
+		formatted = redactedSecret
+	}
+
+	if verb == 'q' || (verb == 'v' && f.Flag('#')) {
+		formatted = strconv.Quote(formatted)
+	}
+
+	io.WriteString(f, formatted)
+}
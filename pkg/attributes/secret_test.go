@@ -0,0 +1,101 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package attributes
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+	"github.com/splunk/go-splunk-client/pkg/values"
+)
+
+type testSecret struct {
+	Value Secret `json:"value" values:",omitzero"`
+}
+
+func TestSecret_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		input  interface{}
+		want   string
+	}{
+		{"v", "%v", Secret("changeme"), "********"},
+		{"s", "%s", Secret("changeme"), "********"},
+		{"q", "%q", Secret("changeme"), `"********"`},
+		{"empty", "%v", Secret(""), ""},
+		{"struct", "%v", testSecret{Value: "changeme"}, "{********}"},
+		{"struct go syntax", "%#v", testSecret{Value: "changeme"}, `attributes.testSecret{Value:"********"}`},
+		{"empty struct go syntax", "%#v", testSecret{}, `attributes.testSecret{Value:""}`},
+		{"error", "%v", fmt.Errorf("invalid password %s", Secret("changeme")), "invalid password ********"},
+	}
+
+	for _, test := range tests {
+		got := fmt.Sprintf(test.format, test.input)
+
+		if got != test.want {
+			t.Errorf("%s: fmt.Sprintf(%q) got %s, want %s", test.name, test.format, got, test.want)
+		}
+	}
+}
+
+func TestSecret_encoding(t *testing.T) {
+	valuesTests := checks.QueryValuesTestCases{
+		{
+			Name:  "empty",
+			Input: testSecret{},
+			Want:  url.Values{},
+		},
+		{
+			Name:  "set",
+			Input: testSecret{Value: "changeme"},
+			Want:  url.Values{"Value": []string{"changeme"}},
+		},
+	}
+
+	valuesTests.Test(t)
+
+	jsonTests := checks.JSONUnmarshalTestCases{
+		{
+			Name:        "set",
+			InputString: `{"value":"changeme"}`,
+			Want:        testSecret{Value: "changeme"},
+		},
+	}
+
+	jsonTests.Test(t)
+
+	gotJSON, err := json.Marshal(testSecret{Value: "changeme"})
+	if err != nil {
+		t.Fatalf("json.Marshal returned error: %s", err)
+	}
+
+	if want := `{"value":"changeme"}`; string(gotJSON) != want {
+		t.Errorf("json.Marshal got %s, want %s", gotJSON, want)
+	}
+
+	_, gotRedactedKeys, err := values.EncodeRedacted(testSecret{Value: "changeme"})
+	if err != nil {
+		t.Fatalf("values.EncodeRedacted returned error: %s", err)
+	}
+
+	if wantRedactedKeys := map[string]bool{"Value": true}; !reflect.DeepEqual(gotRedactedKeys, wantRedactedKeys) {
+		t.Errorf("values.EncodeRedacted got redacted keys %#v, want %#v", gotRedactedKeys, wantRedactedKeys)
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authenticators
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// HECToken provides authentication to the Splunk HTTP Event Collector via a HEC token.
+type HECToken struct {
+	// Token is the HEC token that will be used to authenticate to the HTTP Event Collector.
+	Token string
+}
+
+// AuthenticateRequest adds the HECToken to the http.Request's Header.
+func (t HECToken) AuthenticateRequest(c *client.Client, r *http.Request) error {
+	if t.Token == "" {
+		return fmt.Errorf("attempted to authenticate request with empty HECToken")
+	}
+
+	if r.Header == nil {
+		r.Header = http.Header{}
+	}
+
+	r.Header.Add("Authorization", fmt.Sprintf("Splunk %s", t.Token))
+
+	return nil
+}
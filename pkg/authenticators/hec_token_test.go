@@ -0,0 +1,39 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authenticators
+
+import (
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+)
+
+func TestHECToken_AuthenticateRequest(t *testing.T) {
+	tests := AuthenticatorTestCases{
+		{
+			name:               "empty HECToken",
+			inputAuthenticator: HECToken{},
+			wantError:          true,
+		},
+		{
+			name:               "set HECToken",
+			inputAuthenticator: HECToken{Token: "fake-hec-token"},
+			wantError:          false,
+			requestCheck:       checks.CheckRequestHeaderKeyValue("Authorization", "Splunk fake-hec-token"),
+		},
+	}
+
+	tests.test(t)
+}
@@ -15,6 +15,7 @@
 package authenticators
 
 import (
+	"context"
 	"net/http"
 	"sync"
 
@@ -32,6 +33,8 @@ type Password struct {
 
 	// SessionKey holds the SessionKey after initial authentication occurs. Unless
 	// UseBasicAuth is set to true, this SessionKey will be used to authenticate requests.
+	// If a request is rejected because the SessionKey has expired, it is replaced by
+	// logging in again.
 	SessionKey `url:"-"`
 
 	// mu is used to enable locking to prevent race conditions when checking for and obtaining
@@ -42,21 +45,30 @@ type Password struct {
 	_ client.Namespace `service:"auth/login"`
 }
 
+// loginRequest represents the request sent to auth/login. It is used instead of encoding Password
+// directly, which would copy its mutex while it may be locked by another request.
+type loginRequest struct {
+	Username string `values:"username"`
+	Password string `values:"password"`
+}
+
 // loginResponse represents the response returned from auth/login.
 type loginResponse struct {
 	SessionKey
 }
 
-// authenticate performs the authentication request and handles the response, storing the SessionKey
-// if successful.
-func (p *Password) authenticate(c *client.Client) error {
+// authenticate performs the authentication request with the given Context and handles the response,
+// storing the SessionKey if successful.
+func (p *Password) authenticate(ctx context.Context, c *client.Client) error {
 	lR := loginResponse{}
 
-	if err := c.RequestAndHandle(
+	if err := c.RequestAndHandleContext(
+		ctx,
 		client.ComposeRequestBuilder(
 			client.BuildRequestMethod(http.MethodPost),
 			client.BuildRequestServiceURL(c, p),
-			client.BuildRequestBodyValues(p),
+			client.BuildRequestBodyValues(loginRequest{Username: p.Username, Password: p.Password}),
+			client.BuildRequestDryRunExempt(),
 		),
 		client.ComposeResponseHandler(
 			client.HandleResponseCode(http.StatusUnauthorized, client.HandleResponseXMLMessagesCustomError(client.ErrorUnauthorized)),
@@ -72,23 +84,50 @@ func (p *Password) authenticate(c *client.Client) error {
 	return nil
 }
 
-// authenticateOnce calls authenticate only if currently unauthenticated.
-func (p *Password) authenticateOnce(c *client.Client) error {
+// authenticateOnce calls authenticate only if currently unauthenticated. It returns a copy of the
+// SessionKey taken while locked, so that it can be used without racing a concurrent Reauthenticate.
+func (p *Password) authenticateOnce(ctx context.Context, c *client.Client) (SessionKey, error) {
 	p.mu.Lock()
 	defer p.mu.Unlock()
 
 	if !p.authenticated() {
-		return p.authenticate(c)
+		if err := p.authenticate(ctx, c); err != nil {
+			return SessionKey{}, err
+		}
 	}
 
-	return nil
+	return p.SessionKey, nil
 }
 
-// AuthenticateRequest adds authentication to an http.Request.
+// AuthenticateRequest adds authentication to an http.Request. If a login is required, it is
+// performed with the http.Request's Context.
 func (p *Password) AuthenticateRequest(c *client.Client, r *http.Request) error {
-	if err := p.authenticateOnce(c); err != nil {
+	sessionKey, err := p.authenticateOnce(r.Context(), c)
+	if err != nil {
 		return err
 	}
 
-	return p.SessionKey.AuthenticateRequest(c, r)
+	return sessionKey.AuthenticateRequest(c, r)
+}
+
+// Reauthenticate replaces the SessionKey that was used to authenticate the rejected request by logging
+// in again with the request's Context. The SessionKey is only replaced once the login succeeds. If a
+// new SessionKey has already been obtained by another request, no login is performed. It returns false for requests that weren't authenticated with a
+// SessionKey, such as the login request itself.
+func (p *Password) Reauthenticate(c *client.Client, r *http.Request) (bool, error) {
+	rejectedSessionKey, ok := sessionKeyFromRequest(r)
+	if !ok {
+		return false, nil
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.SessionKey.SessionKey == rejectedSessionKey {
+		if err := p.authenticate(r.Context(), c); err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
 }
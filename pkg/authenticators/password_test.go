@@ -0,0 +1,217 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package authenticators
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// testSessionServer is a fake Splunk REST API that issues sequential session keys, only the most
+// recent of which is valid.
+type testSessionServer struct {
+	mu         sync.Mutex
+	logins     int
+	sessionKey string
+}
+
+func (server *testSessionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	if r.URL.Path == "/services/auth/login" {
+		data, _ := io.ReadAll(r.Body)
+		form, err := url.ParseQuery(string(data))
+		if err != nil || form.Get("password") != "changeme" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`<response><messages><msg code="WARN">Login failed</msg></messages></response>`))
+			return
+		}
+
+		server.logins++
+		server.sessionKey = fmt.Sprintf("session-key-%d", server.logins)
+		_, _ = fmt.Fprintf(w, "<response><sessionKey>%s</sessionKey></response>", server.sessionKey)
+		return
+	}
+
+	if r.Header.Get("Authorization") != fmt.Sprintf("Splunk %s", server.sessionKey) {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = w.Write([]byte(`{"messages":[{"type":"WARN","text":"call not properly authenticated"}]}`))
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// expire invalidates the current session key.
+func (server *testSessionServer) expire() {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	server.sessionKey = "expired"
+}
+
+// testProtected is used to determine the service URL of a protected endpoint.
+type testProtected struct {
+	_ client.Namespace `service:"protected"`
+}
+
+// requestProtected performs a request against the protected endpoint.
+func requestProtected(c *client.Client) error {
+	return c.RequestAndHandleContext(
+		context.Background(),
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodGet),
+			client.BuildRequestServiceURL(c, testProtected{}),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+	)
+}
+
+func TestPassword_Reauthenticate(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputPassword string
+		inputRequests int
+		wantLogins    int
+		wantError     bool
+	}{
+		{
+			name:          "invalid password",
+			inputPassword: "wrong",
+			inputRequests: 1,
+			wantError:     true,
+		},
+		{
+			name:          "single request",
+			inputPassword: "changeme",
+			inputRequests: 1,
+			wantLogins:    2,
+		},
+		{
+			name:          "concurrent requests",
+			inputPassword: "changeme",
+			inputRequests: 20,
+			wantLogins:    2,
+		},
+	}
+
+	for _, test := range tests {
+		server := &testSessionServer{}
+		httpServer := httptest.NewServer(server)
+
+		c := &client.Client{
+			URL: httpServer.URL,
+			Authenticator: &Password{
+				Username: "admin",
+				Password: test.inputPassword,
+			},
+		}
+
+		// establish the initial session before expiring it
+		if err := requestProtected(c); err != nil {
+			if !test.wantError {
+				t.Errorf("%s: initial request returned error: %s", test.name, err)
+			}
+			httpServer.Close()
+			continue
+		}
+
+		server.expire()
+
+		var wg sync.WaitGroup
+		errs := make(chan error, test.inputRequests)
+		for i := 0; i < test.inputRequests; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				errs <- requestProtected(c)
+			}()
+		}
+		wg.Wait()
+		close(errs)
+		httpServer.Close()
+
+		for err := range errs {
+			gotError := err != nil
+			if gotError != test.wantError {
+				t.Errorf("%s: request returned error? %v (%s)", test.name, gotError, err)
+			}
+		}
+
+		if server.logins != test.wantLogins {
+			t.Errorf("%s: got %d logins, want %d", test.name, server.logins, test.wantLogins)
+		}
+	}
+}
+
+func TestPassword_Reauthenticate_repeatedExpiry(t *testing.T) {
+	const expiries = 5
+	const goroutines = 8
+	const requestsPerGoroutine = 10
+
+	server := &testSessionServer{}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	c := &client.Client{
+		URL: httpServer.URL,
+		Authenticator: &Password{
+			Username: "admin",
+			Password: "changeme",
+		},
+	}
+
+	if err := requestProtected(c); err != nil {
+		t.Fatalf("initial request returned error: %s", err)
+	}
+
+	for i := 0; i < expiries; i++ {
+		server.expire()
+
+		var wg sync.WaitGroup
+		errs := make(chan error, goroutines*requestsPerGoroutine)
+		for j := 0; j < goroutines; j++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for k := 0; k < requestsPerGoroutine; k++ {
+					errs <- requestProtected(c)
+				}
+			}()
+		}
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			if err != nil {
+				t.Errorf("expiry %d: request returned error: %s", i, err)
+			}
+		}
+	}
+
+	if wantLogins := expiries + 1; server.logins != wantLogins {
+		t.Errorf("got %d logins, want %d", server.logins, wantLogins)
+	}
+}
@@ -17,6 +17,7 @@ package authenticators
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/splunk/go-splunk-client/pkg/client"
 )
@@ -32,6 +33,21 @@ func (s SessionKey) authenticated() bool {
 	return s.SessionKey != ""
 }
 
+// sessionKeyFromRequest returns the session key used to authenticate an http.Request, and a boolean
+// indicating if one was found.
+func sessionKeyFromRequest(r *http.Request) (string, bool) {
+	if r == nil {
+		return "", false
+	}
+
+	sessionKey := strings.TrimPrefix(r.Header.Get("Authorization"), "Splunk ")
+	if sessionKey == "" || sessionKey == r.Header.Get("Authorization") {
+		return "", false
+	}
+
+	return sessionKey, true
+}
+
 // AuthenticateRequest adds the SessionKey to the http.Request's Header.
 func (s SessionKey) AuthenticateRequest(c *client.Client, r *http.Request) error {
 	if !s.authenticated() {
@@ -0,0 +1,81 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import "context"
+
+// ApplyResult indicates which action was taken by Apply.
+type ApplyResult int
+
+const (
+	// ApplyUnchanged indicates the entry already existed with the desired values.
+	ApplyUnchanged ApplyResult = iota
+
+	// ApplyCreated indicates the entry did not exist, and was created.
+	ApplyCreated
+
+	// ApplyUpdated indicates the entry existed with different values, and was updated.
+	ApplyUpdated
+)
+
+// String returns a human-readable representation of the ApplyResult.
+func (result ApplyResult) String() string {
+	switch result {
+	case ApplyUnchanged:
+		return "unchanged"
+	case ApplyCreated:
+		return "created"
+	case ApplyUpdated:
+		return "updated"
+	default:
+		return "unknown"
+	}
+}
+
+// Apply ensures the given Entry exists with its defined values. It performs a Read, followed by a
+// Create if the entry was not found, or an Update if any of the entry's "update" values differ from
+// those currently stored. The returned ApplyResult indicates which action was taken.
+func (client *Client) Apply(entry interface{}) (ApplyResult, error) {
+	return client.ApplyContext(context.Background(), entry)
+}
+
+// ApplyContext ensures the given Entry exists with its defined values, using the given Context.
+// See Apply for details.
+//
+// Whether an Update is needed is determined by DiffContext.
+func (client *Client) ApplyContext(ctx context.Context, entry interface{}) (ApplyResult, error) {
+	diff, err := client.DiffContext(ctx, entry)
+	if err != nil {
+		return ApplyUnchanged, err
+	}
+
+	if !diff.Exists {
+		if err := client.CreateContext(ctx, entry); err != nil {
+			return ApplyUnchanged, err
+		}
+
+		return ApplyCreated, nil
+	}
+
+	if !diff.Changed() {
+		return ApplyUnchanged, nil
+	}
+
+	if err := client.UpdateContext(ctx, entry); err != nil {
+		return ApplyUnchanged, err
+	}
+
+	return ApplyUpdated, nil
+}
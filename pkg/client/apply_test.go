@@ -0,0 +1,175 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+)
+
+// testContentEntry is an entry type with Content for testing Client operations.
+type testContentEntry struct {
+	ID      ID `service:"test/entries" selective:"create"`
+	Content struct {
+		Value attributes.Explicit[string] `json:"value" values:"value,omitzero"`
+		Other attributes.Explicit[string] `json:"other" values:"other,omitzero"`
+	} `json:"content" values:",anonymize"`
+}
+
+// testEntriesServer is an in-memory store of test/entries content, served over HTTP.
+type testEntriesServer struct {
+	mu      sync.Mutex
+	entries map[string]map[string]string
+	methods []string
+}
+
+func (s *testEntriesServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.methods = append(s.methods, r.Method)
+
+	body, _ := io.ReadAll(r.Body)
+	form, _ := url.ParseQuery(string(body))
+
+	name := strings.TrimPrefix(r.URL.Path, "/services/test/entries")
+	name = strings.TrimPrefix(name, "/")
+
+	if name == "" {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		name = form.Get("name")
+		form.Del("name")
+		s.entries[name] = map[string]string{}
+		for key := range form {
+			s.entries[name][key] = form.Get(key)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	content, ok := s.entries[name]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"messages":[{"type":"ERROR","text":"not found"}]}`))
+		return
+	}
+
+	if r.Method == http.MethodPost {
+		for key := range form {
+			content[key] = form.Get(key)
+		}
+	}
+
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"entry": []interface{}{
+			map[string]interface{}{
+				"id":      "https://localhost:8089" + path.Join("/services/test/entries", name),
+				"content": content,
+			},
+		},
+	})
+}
+
+func TestClient_Apply(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputExisting map[string]map[string]string
+		inputValue    string
+		wantResult    ApplyResult
+		wantMethods   []string
+		wantContent   map[string]string
+	}{
+		{
+			name:          "created",
+			inputExisting: map[string]map[string]string{},
+			inputValue:    "new",
+			wantResult:    ApplyCreated,
+			wantMethods:   []string{http.MethodGet, http.MethodPost},
+			wantContent:   map[string]string{"value": "new"},
+		},
+		{
+			name: "updated",
+			inputExisting: map[string]map[string]string{
+				"test": {"value": "old", "other": "retained"},
+			},
+			inputValue:  "new",
+			wantResult:  ApplyUpdated,
+			wantMethods: []string{http.MethodGet, http.MethodPost},
+			wantContent: map[string]string{"value": "new", "other": "retained"},
+		},
+		{
+			name: "unchanged",
+			inputExisting: map[string]map[string]string{
+				"test": {"value": "same", "other": "ignored"},
+			},
+			inputValue:  "same",
+			wantResult:  ApplyUnchanged,
+			wantMethods: []string{http.MethodGet},
+			wantContent: map[string]string{"value": "same", "other": "ignored"},
+		},
+	}
+
+	for _, test := range tests {
+		store := &testEntriesServer{entries: test.inputExisting}
+		server := httptest.NewServer(store)
+		c := &Client{URL: server.URL, Authenticator: &testAuthenticator{}}
+
+		entry := testContentEntry{ID: ID{Title: "test"}}
+		entry.Content.Value = attributes.NewExplicit(test.inputValue)
+
+		gotResult, err := c.Apply(entry)
+		server.Close()
+
+		if err != nil {
+			t.Errorf("%s: Apply() returned error: %s", test.name, err)
+			continue
+		}
+
+		if gotResult != test.wantResult {
+			t.Errorf("%s: Apply() got %s, want %s", test.name, gotResult, test.wantResult)
+		}
+
+		if strings.Join(store.methods, ",") != strings.Join(test.wantMethods, ",") {
+			t.Errorf("%s: Apply() got methods %v, want %v", test.name, store.methods, test.wantMethods)
+		}
+
+		gotContent := store.entries["test"]
+		if len(gotContent) != len(test.wantContent) {
+			t.Errorf("%s: Apply() got content %v, want %v", test.name, gotContent, test.wantContent)
+			continue
+		}
+
+		for key, wantValue := range test.wantContent {
+			if gotContent[key] != wantValue {
+				t.Errorf("%s: Apply() got content %v, want %v", test.name, gotContent, test.wantContent)
+				break
+			}
+		}
+	}
+}
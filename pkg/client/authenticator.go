@@ -16,7 +16,17 @@ package client
 
 import "net/http"
 
-// Authenticators are capable of adding authentication to requests.
+// Authenticators are capable of adding authentication to requests. Any requests an Authenticator
+// needs to perform to obtain credentials should honor the http.Request's Context.
 type Authenticator interface {
 	AuthenticateRequest(*Client, *http.Request) error
 }
+
+// Reauthenticator is the interface for Authenticators that are able to obtain new credentials after
+// a request they authenticated was rejected as unauthorized, such as when a session has expired.
+type Reauthenticator interface {
+	// Reauthenticate is called with a request that was rejected with a 401 Unauthorized response. It
+	// returns true if new credentials are available and the request should be attempted again. It
+	// may be called concurrently for requests that were authenticated with the same credentials.
+	Reauthenticate(*Client, *http.Request) (bool, error)
+}
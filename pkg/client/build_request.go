@@ -15,10 +15,11 @@
 package client
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"io"
 	"net/http"
-	"net/url"
-	"strings"
 
 	"github.com/splunk/go-splunk-client/pkg/selective"
 	"github.com/splunk/go-splunk-client/pkg/service"
@@ -53,9 +54,9 @@ func ComposeRequestBuilder(builders ...RequestBuilder) RequestBuilder {
 	}
 }
 
-// buildRequest creates a new http.Request and applies the provided RequestBuilder.
-func buildRequest(builder RequestBuilder) (*http.Request, error) {
-	r := &http.Request{}
+// buildRequest creates a new http.Request with the given Context and applies the provided RequestBuilder.
+func buildRequest(ctx context.Context, builder RequestBuilder) (*http.Request, error) {
+	r := (&http.Request{}).WithContext(ctx)
 
 	if err := builder(r); err != nil {
 		return nil, err
@@ -88,37 +89,120 @@ func BuildRequestServiceURL(c *Client, service interface{}) RequestBuilder {
 	}
 }
 
+// BuildRequestServiceActionURL returns a RequestBuilder that sets the URL to a path beneath
+// the service of a given Entry, such as an action.
+func BuildRequestServiceActionURL(c *Client, service interface{}, action ...string) RequestBuilder {
+	return func(r *http.Request) error {
+		u, err := c.ServiceActionURL(service, action...)
+		if err != nil {
+			return err
+		}
+
+		r.URL = u
+
+		return nil
+	}
+}
+
 // BuildRequestBodyValues returns a RequestBuilder that sets the Body to the encoded url.Values for
-// a given interface.
+// a given interface. The request's GetBody is also set, so the Body can be replayed if the request
+// is retried.
 func BuildRequestBodyValues(i interface{}) RequestBuilder {
 	return func(r *http.Request) error {
-		v, err := values.Encode(i)
+		v, redactedKeys, err := values.EncodeRedacted(i)
 		if err != nil {
 			return wrapError(ErrorValues, err, err.Error())
 		}
 
-		r.Body = io.NopCloser(strings.NewReader(v.Encode()))
+		*r = *withRedactedKeys(r, redactedKeys)
+
+		return BuildRequestBody([]byte(v.Encode()))(r)
+	}
+}
+
+// BuildRequestBodyJSON returns a RequestBuilder that sets the Body to the JSON encoding of a given
+// interface, and sets the Content-Type header to application/json.
+func BuildRequestBodyJSON(i interface{}) RequestBuilder {
+	return func(r *http.Request) error {
+		body, err := json.Marshal(i)
+		if err != nil {
+			return wrapError(ErrorJSON, err, "unable to encode JSON body: %s", err)
+		}
+
+		return ComposeRequestBuilder(
+			BuildRequestHeader("Content-Type", "application/json"),
+			BuildRequestBody(body),
+		)(r)
+	}
+}
+
+// BuildRequestBody returns a RequestBuilder that sets the Body to the given content. The request's
+// GetBody is also set, so the Body can be replayed if the request is retried.
+func BuildRequestBody(body []byte) RequestBuilder {
+	return func(r *http.Request) error {
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+		r.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+
+		return nil
+	}
+}
+
+// BuildRequestHeader returns a RequestBuilder that sets the given header key to value.
+func BuildRequestHeader(key string, value string) RequestBuilder {
+	return func(r *http.Request) error {
+		if r.Header == nil {
+			r.Header = http.Header{}
+		}
+
+		r.Header.Set(key, value)
 
 		return nil
 	}
 }
 
-// BuildRequestOutputModeJSON returns a RequestBuilder that sets the URL's RawQuery to output_mode=json.
-// It checks that the URL is already set, so it must be applied after setting the URL. It overwrites
-// any existing RawQuery Values.
+// BuildRequestOutputModeJSON returns a RequestBuilder that sets the URL's output_mode query parameter
+// to json. It checks that the URL is already set, so it must be applied after setting the URL. Other
+// existing RawQuery values are retained.
 func BuildRequestOutputModeJSON() RequestBuilder {
 	return func(r *http.Request) error {
 		if r.URL == nil {
 			return wrapError(ErrorNilValue, nil, "unable to set output mode on nil URL")
 		}
 
-		if r.URL.RawQuery != "" {
-			return wrapError(ErrorOverwriteValue, nil, "attempted to set output_mode after RawQuery already set")
+		query := r.URL.Query()
+		query.Set("output_mode", "json")
+
+		r.URL.RawQuery = query.Encode()
+
+		return nil
+	}
+}
+
+// BuildRequestQueryValues returns a RequestBuilder that adds the encoded url.Values for a given
+// interface to the URL's RawQuery. It checks that the URL is already set, so it must be applied
+// after setting the URL. Existing RawQuery values are retained.
+func BuildRequestQueryValues(i interface{}) RequestBuilder {
+	return func(r *http.Request) error {
+		if r.URL == nil {
+			return wrapError(ErrorNilValue, nil, "unable to set query values on nil URL")
+		}
+
+		v, err := values.Encode(i)
+		if err != nil {
+			return wrapError(ErrorValues, err, err.Error())
+		}
+
+		query := r.URL.Query()
+		for key, keyValues := range v {
+			for _, keyValue := range keyValues {
+				query.Add(key, keyValue)
+			}
 		}
 
-		r.URL.RawQuery = url.Values{
-			"output_mode": []string{"json"},
-		}.Encode()
+		r.URL.RawQuery = query.Encode()
 
 		return nil
 	}
@@ -167,7 +251,24 @@ func BuildRequestEntryACLURL(c *Client, entry interface{}, acl ACL) RequestBuild
 	}
 }
 
+// BuildRequestEntryActionURL returns a RequestBuilder that sets the URL to a path beneath
+// a given Entry, such as an action or sub-resource.
+func BuildRequestEntryActionURL(c *Client, entry interface{}, action ...string) RequestBuilder {
+	return func(r *http.Request) error {
+		u, err := c.EntryActionURL(entry, action...)
+		if err != nil {
+			return err
+		}
+
+		r.URL = u
+
+		return nil
+	}
+}
+
 // BuildRequestAuthenticate returns a RequestBuilder that authenticates a request for a given Client.
+// The http.Request's Context is available to the Authenticator, so it must be applied after the
+// request has been created by RequestAndHandleContext.
 func BuildRequestAuthenticate(c *Client) RequestBuilder {
 	return func(r *http.Request) error {
 		return c.Authenticator.AuthenticateRequest(c, r)
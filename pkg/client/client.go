@@ -16,6 +16,7 @@
 package client
 
 import (
+	"context"
 	"crypto/tls"
 	"net/http"
 	"net/http/cookiejar"
@@ -24,7 +25,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/splunk/go-splunk-client/pkg/deepset"
 	"github.com/splunk/go-splunk-client/pkg/internal/paths"
 	"github.com/splunk/go-splunk-client/pkg/service"
 	"golang.org/x/net/publicsuffix"
@@ -54,6 +54,14 @@ type Client struct {
 	// Timeout configures the timeout of requests. If unspecified, defaults to 5 minutes.
 	Timeout time.Duration
 
+	// RetryPolicy configures retries of requests that fail with transient errors. If unspecified,
+	// requests are not retried.
+	RetryPolicy RetryPolicy
+
+	// DryRun enables dry-run mode if set. Requests that would modify the Splunk instance are
+	// recorded by DryRun instead of being sent.
+	DryRun *DryRun
+
 	httpClient *http.Client
 	mu         sync.Mutex
 }
@@ -81,6 +89,17 @@ func (c *Client) ServiceURL(s interface{}) (*url.URL, error) {
 	return c.urlForPath(servicePath)
 }
 
+// ServiceActionURL returns a url.URL for a path beneath a Service, such as an action, relative to
+// the Client's URL.
+func (c *Client) ServiceActionURL(s interface{}, action ...string) (*url.URL, error) {
+	servicePath, err := service.ServicePath(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.urlForPath(append([]string{servicePath}, action...)...)
+}
+
 // EntryURL returns a url.URL for an Entry, relative to the Client's URL.
 func (c *Client) EntryURL(e interface{}) (*url.URL, error) {
 	entryPath, err := service.EntryPath(e)
@@ -92,12 +111,18 @@ func (c *Client) EntryURL(e interface{}) (*url.URL, error) {
 }
 
 func (c *Client) EntryACLURL(e interface{}) (*url.URL, error) {
+	return c.EntryActionURL(e, "acl")
+}
+
+// EntryActionURL returns a url.URL for a path beneath an Entry, such as an action or
+// sub-resource, relative to the Client's URL.
+func (c *Client) EntryActionURL(e interface{}, action ...string) (*url.URL, error) {
 	entryPath, err := service.EntryPath(e)
 	if err != nil {
 		return nil, err
 	}
 
-	return c.urlForPath(entryPath, "acl")
+	return c.urlForPath(append([]string{entryPath}, action...)...)
 }
 
 // httpClientPrep prepares the Client's http.Client.
@@ -131,42 +156,112 @@ func (c *Client) httpClientPrep() error {
 	return nil
 }
 
-// do performs a given http.Request via the Client's http.Client.
+// do performs a given http.Request via the Client's http.Client, retrying as configured by
+// the Client's RetryPolicy.
 func (c *Client) do(r *http.Request) (*http.Response, error) {
 	if err := c.httpClientPrep(); err != nil {
 		return nil, err
 	}
 
-	resp, err := c.httpClient.Do(r)
-	if err != nil {
-		return nil, wrapError(ErrorHTTPClient, err, "error encountered performing request: %s", err)
-	}
+	for attempt := 1; ; attempt++ {
+		attemptR, err := requestForAttempt(r, attempt)
+		if err != nil {
+			return nil, wrapError(ErrorHTTPClient, err, "unable to replay request body: %s", err)
+		}
+
+		resp, err := c.httpClient.Do(attemptR)
+		if !c.RetryPolicy.shouldRetry(attempt, r, resp, err) {
+			if err != nil {
+				return nil, wrapError(ErrorHTTPClient, err, "error encountered performing request: %s", err)
+			}
+
+			return resp, nil
+		}
 
-	return resp, nil
+		if resp != nil {
+			discardResponse(resp)
+		}
+
+		if err := c.RetryPolicy.wait(r.Context(), attempt, resp); err != nil {
+			return nil, wrapError(ErrorHTTPClient, err, "error encountered waiting to retry request: %s", err)
+		}
+	}
 }
 
 // RequestAndHandle creates a new http.Request from the given RequestBuilder, performs the
 // request, and handles the http.Response with the given ResponseHandler.
 func (c *Client) RequestAndHandle(builder RequestBuilder, handler ResponseHandler) error {
-	req, err := buildRequest(builder)
+	return c.RequestAndHandleContext(context.Background(), builder, handler)
+}
+
+// RequestAndHandleContext creates a new http.Request with the given Context from the given
+// RequestBuilder, performs the request, and handles the http.Response with the given ResponseHandler.
+//
+// If the request is rejected as unauthorized and the Client's Authenticator is a Reauthenticator,
+// the request is built and performed once more if the Authenticator was able to obtain new credentials.
+//
+// If the Client has a DryRun, requests that would modify state are recorded instead of performed, and
+// handler is not called.
+func (c *Client) RequestAndHandleContext(ctx context.Context, builder RequestBuilder, handler ResponseHandler) error {
+	req, err := buildRequest(ctx, builder)
 	if err != nil {
 		return err
 	}
 
+	if c.DryRun != nil && requestDryRunRecorded(req) {
+		return c.DryRun.record(req)
+	}
+
 	resp, err := c.do(req)
 	if err != nil {
 		return err
 	}
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		if reauthenticator, ok := c.Authenticator.(Reauthenticator); ok {
+			retry, err := reauthenticator.Reauthenticate(c, resp.Request)
+			if err != nil {
+				resp.Body.Close()
+				return err
+			}
+
+			if retry {
+				discardResponse(resp)
+
+				resp, err = c.buildAndDo(ctx, builder)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
 	defer resp.Body.Close()
 
 	return handler(resp)
 }
 
+// buildAndDo creates a new http.Request with the given Context from the given RequestBuilder, and
+// performs the request.
+func (c *Client) buildAndDo(ctx context.Context, builder RequestBuilder) (*http.Response, error) {
+	req, err := buildRequest(ctx, builder)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.do(req)
+}
+
 // Create performs a Create action for the given Entry.
 func (client *Client) Create(entry interface{}) error {
+	return client.CreateContext(context.Background(), entry)
+}
+
+// CreateContext performs a Create action for the given Entry, using the given Context.
+func (client *Client) CreateContext(ctx context.Context, entry interface{}) error {
 	var codes service.StatusCodes
 
-	return client.RequestAndHandle(
+	return client.RequestAndHandleContext(
+		ctx,
 		ComposeRequestBuilder(
 			BuildRequestGetServiceStatusCodes(entry, &codes),
 			BuildRequestMethod(http.MethodPost),
@@ -184,9 +279,16 @@ func (client *Client) Create(entry interface{}) error {
 // Read performs a Read action for the given Entry. It modifies entry in-place,
 // so entry must be a pointer.
 func (client *Client) Read(entry interface{}) error {
+	return client.ReadContext(context.Background(), entry)
+}
+
+// ReadContext performs a Read action for the given Entry, using the given Context. It modifies
+// entry in-place, so entry must be a pointer.
+func (client *Client) ReadContext(ctx context.Context, entry interface{}) error {
 	var codes service.StatusCodes
 
-	return client.RequestAndHandle(
+	return client.RequestAndHandleContext(
+		ctx,
 		ComposeRequestBuilder(
 			BuildRequestGetServiceStatusCodes(entry, &codes),
 			BuildRequestMethod(http.MethodGet),
@@ -204,15 +306,22 @@ func (client *Client) Read(entry interface{}) error {
 
 // Update performs an Update action for the given Entry.
 func (client *Client) Update(entry interface{}) error {
+	return client.UpdateContext(context.Background(), entry)
+}
+
+// UpdateContext performs an Update action for the given Entry, using the given Context.
+func (client *Client) UpdateContext(ctx context.Context, entry interface{}) error {
 	var codes service.StatusCodes
 
-	return client.RequestAndHandle(
+	return client.RequestAndHandleContext(
+		ctx,
 		ComposeRequestBuilder(
 			BuildRequestGetServiceStatusCodes(entry, &codes),
 			BuildRequestMethod(http.MethodPost),
 			BuildRequestEntryURL(client, entry),
 			BuildRequestOutputModeJSON(),
 			BuildRequestBodyValuesSelective(entry, "update"),
+			BuildRequestIdempotent(),
 			BuildRequestAuthenticate(client),
 		),
 		ComposeResponseHandler(
@@ -223,9 +332,15 @@ func (client *Client) Update(entry interface{}) error {
 
 // Delete performs a Delete action for the given Entry.
 func (client *Client) Delete(entry interface{}) error {
+	return client.DeleteContext(context.Background(), entry)
+}
+
+// DeleteContext performs a Delete action for the given Entry, using the given Context.
+func (client *Client) DeleteContext(ctx context.Context, entry interface{}) error {
 	var codes service.StatusCodes
 
-	return client.RequestAndHandle(
+	return client.RequestAndHandleContext(
+		ctx,
 		ComposeRequestBuilder(
 			BuildRequestGetServiceStatusCodes(entry, &codes),
 			BuildRequestMethod(http.MethodDelete),
@@ -239,62 +354,96 @@ func (client *Client) Delete(entry interface{}) error {
 	)
 }
 
-func (client *Client) listModified(entries interface{}, modifier interface{}) error {
-	entriesPtrV := reflect.ValueOf(entries)
-	if entriesPtrV.Kind() != reflect.Ptr {
-		return wrapError(ErrorPtr, nil, "client: List attempted on on-pointer value")
+// listModified populates entries with every page of entries for the collection determined by
+// modifier, which may be nil, a Namespace, or an ID.
+func (client *Client) listModified(ctx context.Context, entries interface{}, modifier interface{}, opts []ListOptions) error {
+	return listAll(entries, client.newEntryIterator(ctx, entries, modifier, opts))
+}
+
+// listAll populates entries with every page of entries returned by iterator, which must have been
+// created for entries.
+func listAll(entries interface{}, iterator *EntryIterator) error {
+	if err := iterator.Err(); err != nil {
+		return err
 	}
 
-	entriesV := reflect.Indirect(entriesPtrV)
-	if entriesV.Kind() != reflect.Slice {
-		return wrapError(ErrorSlice, nil, "client: List attempted on non-slice value")
+	entriesV := reflect.Indirect(reflect.ValueOf(entries))
+	allEntriesV := reflect.MakeSlice(entriesV.Type(), 0, 0)
+
+	for iterator.Next() {
+		allEntriesV = reflect.AppendSlice(allEntriesV, entriesV)
 	}
-	entryT := entriesV.Type().Elem()
-	entryI := reflect.New(entryT).Interface()
 
-	if modifier != nil {
-		if err := deepset.Set(entryI, modifier); err != nil {
-			return err
-		}
+	if err := iterator.Err(); err != nil {
+		return err
 	}
 
-	return client.RequestAndHandle(
-		ComposeRequestBuilder(
-			BuildRequestMethod(http.MethodGet),
-			BuildRequestEntryURL(client, entryI),
-			BuildRequestOutputModeJSON(),
-			BuildRequestAuthenticate(client),
-		),
-		ComposeResponseHandler(
-			HandleResponseRequireCode(http.StatusOK, HandleResponseJSONMessagesError()),
-			HandleResponseEntries(entries),
-		),
-	)
+	entriesV.Set(allEntriesV)
+
+	return nil
+}
+
+// ListNamespace populates entries in place for a Namespace. At most one ListOptions may be given.
+func (client *Client) ListNamespace(entries interface{}, ns Namespace, opts ...ListOptions) error {
+	return client.ListNamespaceContext(context.Background(), entries, ns, opts...)
+}
+
+// ListNamespaceContext populates entries in place for a Namespace, using the given Context. At most
+// one ListOptions may be given.
+func (client *Client) ListNamespaceContext(ctx context.Context, entries interface{}, ns Namespace, opts ...ListOptions) error {
+	return client.listModified(ctx, entries, ns, opts)
+}
+
+// ListID populates entries in place for an ID. At most one ListOptions may be given.
+func (client *Client) ListID(entries interface{}, id ID, opts ...ListOptions) error {
+	return client.ListIDContext(context.Background(), entries, id, opts...)
+}
+
+// ListIDContext populates entries in place for an ID, using the given Context. At most one ListOptions
+// may be given.
+func (client *Client) ListIDContext(ctx context.Context, entries interface{}, id ID, opts ...ListOptions) error {
+	return client.listModified(ctx, entries, id, opts)
 }
 
-// ListNamespace populates entries in place for a Namespace.
-func (client *Client) ListNamespace(entries interface{}, ns Namespace) error {
-	return client.listModified(entries, ns)
+// List populates entries in place without any ID or Namespace context. At most one ListOptions
+// may be given.
+func (client *Client) List(entries interface{}, opts ...ListOptions) error {
+	return client.ListContext(context.Background(), entries, opts...)
 }
 
-// ListNamespace populates entries in place for an ID.
-func (client *Client) ListID(entries interface{}, id ID) error {
-	return client.listModified(entries, id)
+// ListContext populates entries in place without any ID or Namespace context, using the given Context.
+// At most one ListOptions may be given.
+func (client *Client) ListContext(ctx context.Context, entries interface{}, opts ...ListOptions) error {
+	return client.listModified(ctx, entries, nil, opts)
 }
 
-// ListNamespace populates entries in place without any ID or Namespace context.
-func (client *Client) List(entries interface{}) error {
-	return client.listModified(entries, nil)
+// ListEntryAction populates entries in place with the entries listed by an action or sub-resource
+// of entry. At most one ListOptions may be given.
+func (client *Client) ListEntryAction(entries interface{}, entry interface{}, action string, opts ...ListOptions) error {
+	return client.ListEntryActionContext(context.Background(), entries, entry, action, opts...)
+}
+
+// ListEntryActionContext populates entries in place with the entries listed by an action or
+// sub-resource of entry, using the given Context. At most one ListOptions may be given.
+func (client *Client) ListEntryActionContext(ctx context.Context, entries interface{}, entry interface{}, action string, opts ...ListOptions) error {
+	return listAll(entries, client.IterateEntryAction(ctx, entries, entry, action, opts...))
 }
 
 // ReadACL performs a ReadACL action for the given Entry. It modifies acl in-place,
 // so acl must be a pointer.
 func (client *Client) ReadACL(entry interface{}, acl *ACL) error {
+	return client.ReadACLContext(context.Background(), entry, acl)
+}
+
+// ReadACLContext performs a ReadACL action for the given Entry, using the given Context. It modifies
+// acl in-place, so acl must be a pointer.
+func (client *Client) ReadACLContext(ctx context.Context, entry interface{}, acl *ACL) error {
 	var aclResponse struct {
 		ACL ACL `json:"acl"`
 	}
 
-	if err := client.RequestAndHandle(
+	if err := client.RequestAndHandleContext(
+		ctx,
 		ComposeRequestBuilder(
 			BuildRequestMethod(http.MethodGet),
 			BuildRequestEntryACLURL(client, entry, *acl),
@@ -317,12 +466,84 @@ func (client *Client) ReadACL(entry interface{}, acl *ACL) error {
 
 // UpdateACL performs an UpdateACL action for the given Entry.
 func (client *Client) UpdateACL(entry interface{}, acl ACL) error {
-	return client.RequestAndHandle(
+	return client.UpdateACLContext(context.Background(), entry, acl)
+}
+
+// UpdateACLContext performs an UpdateACL action for the given Entry, using the given Context.
+func (client *Client) UpdateACLContext(ctx context.Context, entry interface{}, acl ACL) error {
+	return client.RequestAndHandleContext(
+		ctx,
 		ComposeRequestBuilder(
 			BuildRequestMethod(http.MethodPost),
 			BuildRequestEntryACLURL(client, entry, acl),
 			BuildRequestBodyValues(acl),
 			BuildRequestOutputModeJSON(),
+			BuildRequestIdempotent(),
+			BuildRequestAuthenticate(client),
+		),
+		ComposeResponseHandler(
+			HandleResponseRequireCode(http.StatusOK, HandleResponseJSONMessagesError()),
+		),
+	)
+}
+
+// Enable performs an Enable action for the given Entry.
+func (client *Client) Enable(entry interface{}) error {
+	return client.EnableContext(context.Background(), entry)
+}
+
+// EnableContext performs an Enable action for the given Entry, using the given Context.
+func (client *Client) EnableContext(ctx context.Context, entry interface{}) error {
+	return client.entryAction(ctx, entry, "enable")
+}
+
+// Disable performs a Disable action for the given Entry.
+func (client *Client) Disable(entry interface{}) error {
+	return client.DisableContext(context.Background(), entry)
+}
+
+// DisableContext performs a Disable action for the given Entry, using the given Context.
+func (client *Client) DisableContext(ctx context.Context, entry interface{}) error {
+	return client.entryAction(ctx, entry, "disable")
+}
+
+// entryAction performs a POST request without parameters to the given action of an Entry.
+func (client *Client) entryAction(ctx context.Context, entry interface{}, action string) error {
+	var codes service.StatusCodes
+
+	return client.RequestAndHandleContext(
+		ctx,
+		ComposeRequestBuilder(
+			BuildRequestGetServiceStatusCodes(entry, &codes),
+			BuildRequestMethod(http.MethodPost),
+			BuildRequestEntryActionURL(client, entry, action),
+			BuildRequestOutputModeJSON(),
+			BuildRequestIdempotent(),
+			BuildRequestAuthenticate(client),
+		),
+		ComposeResponseHandler(
+			HandleResponseCode(codes.NotFound, HandleResponseJSONMessagesCustomError(ErrorNotFound)),
+			HandleResponseRequireCode(http.StatusOK, HandleResponseJSONMessagesError()),
+		),
+	)
+}
+
+// Reload performs a Reload action for the service of the given Entry, causing Splunk to reload
+// its configuration from disk.
+func (client *Client) Reload(entry interface{}) error {
+	return client.ReloadContext(context.Background(), entry)
+}
+
+// ReloadContext performs a Reload action for the service of the given Entry, using the given Context.
+// Although it is a GET request, it is recorded instead of sent in dry-run mode, as it changes state.
+func (client *Client) ReloadContext(ctx context.Context, entry interface{}) error {
+	return client.RequestAndHandleContext(
+		ctx,
+		ComposeRequestBuilder(
+			BuildRequestMethod(http.MethodGet),
+			BuildRequestServiceActionURL(client, entry, "_reload"),
+			BuildRequestOutputModeJSON(),
+			BuildRequestDryRunRecorded(),
 			BuildRequestAuthenticate(client),
 		),
 		ComposeResponseHandler(
@@ -0,0 +1,185 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+)
+
+// testAuthenticator is an Authenticator that records the Context of the requests it authenticates.
+type testAuthenticator struct {
+	gotContext context.Context
+}
+
+func (a *testAuthenticator) AuthenticateRequest(c *Client, r *http.Request) error {
+	a.gotContext = r.Context()
+
+	return nil
+}
+
+type testContextKey struct{}
+
+func TestClient_RequestAndHandleContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/services/slow" {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(time.Second * 5):
+			}
+		}
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	canceledCtx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	deadlineCtx, deadlineCancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer deadlineCancel()
+
+	valueCtx := context.WithValue(context.Background(), testContextKey{}, "value")
+
+	tests := []struct {
+		name          string
+		inputContext  context.Context
+		inputPath     string
+		wantError     bool
+		wantHandled   bool
+		wantAuthValue interface{}
+	}{
+		{
+			name:         "canceled",
+			inputContext: canceledCtx,
+			inputPath:    "fast",
+			wantError:    true,
+		},
+		{
+			name:         "deadline exceeded",
+			inputContext: deadlineCtx,
+			inputPath:    "slow",
+			wantError:    true,
+		},
+		{
+			name:          "context passed to authenticator",
+			inputContext:  valueCtx,
+			inputPath:     "fast",
+			wantHandled:   true,
+			wantAuthValue: "value",
+		},
+	}
+
+	for _, test := range tests {
+		authenticator := &testAuthenticator{}
+		c := &Client{URL: server.URL, Authenticator: authenticator}
+
+		gotHandled := false
+		err := c.RequestAndHandleContext(
+			test.inputContext,
+			ComposeRequestBuilder(
+				BuildRequestMethod(http.MethodGet),
+				func(r *http.Request) error {
+					u, err := c.urlForPath("services", test.inputPath)
+					r.URL = u
+
+					return err
+				},
+				BuildRequestAuthenticate(c),
+			),
+			func(r *http.Response) error {
+				gotHandled = true
+
+				return nil
+			},
+		)
+		gotError := err != nil
+
+		if gotError != test.wantError {
+			t.Errorf("%s: RequestAndHandleContext() returned error? %v (%s)", test.name, gotError, err)
+		}
+
+		if gotHandled != test.wantHandled {
+			t.Errorf("%s: RequestAndHandleContext() handled response? %v, want %v", test.name, gotHandled, test.wantHandled)
+		}
+
+		if test.wantAuthValue != nil {
+			if gotAuthValue := authenticator.gotContext.Value(testContextKey{}); gotAuthValue != test.wantAuthValue {
+				t.Errorf("%s: AuthenticateRequest() got context value %v, want %v", test.name, gotAuthValue, test.wantAuthValue)
+			}
+		}
+	}
+}
+
+func TestClient_Reload(t *testing.T) {
+	server := checks.NewCheckRequestServer(
+		t,
+		checks.ComposeCheckRequestFunc(
+			checks.CheckRequestMethod(http.MethodGet),
+			checks.CheckRequestURL("/servicesNS/nobody/search/test/entries/_reload?output_mode=json"),
+		),
+		http.StatusOK,
+		"",
+	)
+	defer server.Close()
+
+	c := &Client{URL: server.URL, Authenticator: &testAuthenticator{}}
+
+	if err := c.Reload(testEntry{ID: ID{Namespace: Namespace{User: "nobody", App: "search"}}}); err != nil {
+		t.Errorf("Reload returned error: %s", err)
+	}
+}
+
+func TestClient_EnableDisable(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputFunc    func(*Client, interface{}) error
+		requestCheck checks.CheckRequestFunc
+	}{
+		{
+			name:      "enable",
+			inputFunc: (*Client).Enable,
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodPost),
+				checks.CheckRequestURL("/services/test/entries/test/enable?output_mode=json"),
+			),
+		},
+		{
+			name:      "disable",
+			inputFunc: (*Client).Disable,
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodPost),
+				checks.CheckRequestURL("/services/test/entries/test/disable?output_mode=json"),
+			),
+		},
+	}
+
+	for _, test := range tests {
+		server := checks.NewCheckRequestServer(t, test.requestCheck, http.StatusOK, "")
+		c := &Client{URL: server.URL, Authenticator: &testAuthenticator{}}
+
+		err := test.inputFunc(c, testEntry{ID: ID{Title: "test"}})
+		server.Close()
+
+		if err != nil {
+			t.Errorf("%s: returned error: %s", test.name, err)
+		}
+	}
+}
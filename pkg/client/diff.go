@@ -0,0 +1,204 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/splunk/go-splunk-client/pkg/selective"
+	"github.com/splunk/go-splunk-client/pkg/values"
+)
+
+// Change is a field-level difference between a current and desired entry. Key is the encoded
+// key of the field, as it would be sent to the Splunk REST API.
+//
+// If the field's value is secret, such as an attributes.Secret, Redacted is true and the non-empty
+// Current and Desired values are masked.
+type Change struct {
+	Key      string
+	Current  []string
+	Desired  []string
+	Redacted bool
+}
+
+// formatChangeValues returns a human-readable representation of a Change's values.
+func formatChangeValues(v []string) string {
+	switch len(v) {
+	case 0:
+		return "(unset)"
+	case 1:
+		return strconv.Quote(v[0])
+	default:
+		return fmt.Sprintf("%q", v)
+	}
+}
+
+// String returns a human-readable representation of the Change.
+func (change Change) String() string {
+	return fmt.Sprintf("%s: %s => %s", change.Key, formatChangeValues(change.Current), formatChangeValues(change.Desired))
+}
+
+// EntryDiff is the result of comparing a desired entry against its current state.
+type EntryDiff struct {
+	// Exists indicates if the entry currently exists. If it doesn't, Changes
+	// contains every desired value.
+	Exists  bool
+	Changes []Change
+}
+
+// Changed returns true if the desired entry differs from its current state.
+func (diff EntryDiff) Changed() bool {
+	return !diff.Exists || len(diff.Changes) > 0
+}
+
+// String returns a human-readable representation of the EntryDiff, with one Change per line.
+func (diff EntryDiff) String() string {
+	lines := make([]string, 0, len(diff.Changes)+1)
+	if !diff.Exists {
+		lines = append(lines, "(entry does not exist)")
+	}
+
+	for _, change := range diff.Changes {
+		lines = append(lines, change.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Diff reads the current state of the given Entry and compares it against entry. Only the values
+// that would be sent by an Update are compared, and values that are unset (and omitted) in entry
+// are ignored. This means that only attributes.Explicit values that have been set are compared,
+// and only the parameters and stanza values present in entry are considered.
+func (client *Client) Diff(entry interface{}) (EntryDiff, error) {
+	return client.DiffContext(context.Background(), entry)
+}
+
+// DiffContext reads the current state of the given Entry and compares it against entry, using
+// the given Context. See Diff for details.
+func (client *Client) DiffContext(ctx context.Context, entry interface{}) (EntryDiff, error) {
+	currentPtrV := reflect.New(reflect.Indirect(reflect.ValueOf(entry)).Type())
+	currentPtrV.Elem().Set(reflect.Indirect(reflect.ValueOf(entry)))
+	current := currentPtrV.Interface()
+
+	if err := client.ReadContext(ctx, current); err != nil {
+		if !errors.Is(err, ErrNotFound) {
+			return EntryDiff{}, err
+		}
+
+		changes, err := DiffEntries(nil, entry)
+		if err != nil {
+			return EntryDiff{}, err
+		}
+
+		return EntryDiff{Changes: changes}, nil
+	}
+
+	changes, err := DiffEntries(current, entry)
+	if err != nil {
+		return EntryDiff{}, err
+	}
+
+	return EntryDiff{Exists: true, Changes: changes}, nil
+}
+
+// DiffEntries compares current and desired entries, returning a Change for each value that would be
+// sent by an Update of desired that differs from current. Changes are sorted by Key. If current is
+// nil, every desired value is returned as a Change. The values of secret fields are masked.
+func DiffEntries(current interface{}, desired interface{}) ([]Change, error) {
+	desiredValues, redactedKeys, err := updateValues(desired)
+	if err != nil {
+		return nil, err
+	}
+
+	currentValues := url.Values{}
+	if current != nil {
+		var currentRedactedKeys map[string]bool
+		currentValues, currentRedactedKeys, err = updateValues(current)
+		if err != nil {
+			return nil, err
+		}
+
+		for key := range currentRedactedKeys {
+			redactedKeys[key] = true
+		}
+	}
+
+	keys := make([]string, 0, len(desiredValues))
+	for key := range desiredValues {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var changes []Change
+	for _, key := range keys {
+		if stringsEqual(currentValues[key], desiredValues[key]) {
+			continue
+		}
+
+		change := Change{
+			Key:     key,
+			Current: currentValues[key],
+			Desired: desiredValues[key],
+		}
+
+		if redactedKeys[key] {
+			change.Current = redactStrings(change.Current)
+			change.Desired = redactStrings(change.Desired)
+			change.Redacted = true
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+// updateValues returns the url.Values that would be sent for an Update of entry, and the keys whose
+// values must be redacted.
+func updateValues(entry interface{}) (url.Values, map[string]bool, error) {
+	selected, err := selective.Encode(entry, "update")
+	if err != nil {
+		return nil, nil, wrapError(ErrorValues, err, err.Error())
+	}
+
+	v, redactedKeys, err := values.EncodeRedacted(selected)
+	if err != nil {
+		return nil, nil, wrapError(ErrorValues, err, err.Error())
+	}
+
+	return v, redactedKeys, nil
+}
+
+// stringsEqual returns true if a and b contain the same values in the same order.
+func stringsEqual(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,167 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+)
+
+// testSecretEntry is an entry type with a secret value.
+type testSecretEntry struct {
+	ID      ID `service:"test/entries" selective:"create"`
+	Content struct {
+		Value    attributes.Explicit[string] `json:"value" values:"value,omitzero"`
+		Password attributes.Secret           `json:"password" values:"password,omitzero"`
+	} `json:"content" values:",anonymize"`
+}
+
+func TestDiffEntries(t *testing.T) {
+	newEntry := func(value attributes.Explicit[string], other attributes.Explicit[string]) testContentEntry {
+		entry := testContentEntry{ID: ID{Title: "test"}}
+		entry.Content.Value = value
+		entry.Content.Other = other
+
+		return entry
+	}
+
+	tests := []struct {
+		name         string
+		inputCurrent interface{}
+		inputDesired interface{}
+		want         []Change
+	}{
+		{
+			name:         "unset desired values ignored",
+			inputCurrent: newEntry(attributes.NewExplicit("current"), attributes.NewExplicit("current")),
+			inputDesired: newEntry(attributes.Explicit[string]{}, attributes.Explicit[string]{}),
+		},
+		{
+			name:         "equal",
+			inputCurrent: newEntry(attributes.NewExplicit("same"), attributes.NewExplicit("current")),
+			inputDesired: newEntry(attributes.NewExplicit("same"), attributes.Explicit[string]{}),
+		},
+		{
+			name:         "explicitly set to zero value",
+			inputCurrent: newEntry(attributes.NewExplicit("current"), attributes.Explicit[string]{}),
+			inputDesired: newEntry(attributes.NewExplicit(""), attributes.Explicit[string]{}),
+			want: []Change{
+				{Key: "value", Current: []string{"current"}, Desired: []string{""}},
+			},
+		},
+		{
+			name:         "sorted changes",
+			inputCurrent: newEntry(attributes.NewExplicit("current"), attributes.Explicit[string]{}),
+			inputDesired: newEntry(attributes.NewExplicit("desired"), attributes.NewExplicit("desired")),
+			want: []Change{
+				{Key: "other", Desired: []string{"desired"}},
+				{Key: "value", Current: []string{"current"}, Desired: []string{"desired"}},
+			},
+		},
+		{
+			name:         "nil current",
+			inputDesired: newEntry(attributes.NewExplicit("desired"), attributes.Explicit[string]{}),
+			want: []Change{
+				{Key: "value", Desired: []string{"desired"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got, err := DiffEntries(test.inputCurrent, test.inputDesired)
+		if err != nil {
+			t.Errorf("%s: DiffEntries() returned error: %s", test.name, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: DiffEntries() got\n%#v, want\n%#v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestEntryDiff_String(t *testing.T) {
+	tests := []struct {
+		name  string
+		input EntryDiff
+		want  string
+	}{
+		{
+			name:  "unchanged",
+			input: EntryDiff{Exists: true},
+			want:  "",
+		},
+		{
+			name: "changed",
+			input: EntryDiff{
+				Exists: true,
+				Changes: []Change{
+					{Key: "other", Desired: []string{"desired"}},
+					{Key: "values", Current: []string{"a", "b"}, Desired: []string{"a"}},
+				},
+			},
+			want: "other: (unset) => \"desired\"\nvalues: [\"a\" \"b\"] => \"a\"",
+		},
+		{
+			name: "missing",
+			input: EntryDiff{
+				Changes: []Change{
+					{Key: "value", Desired: []string{"desired"}},
+				},
+			},
+			want: "(entry does not exist)\nvalue: (unset) => \"desired\"",
+		},
+	}
+
+	for _, test := range tests {
+		if got := test.input.String(); got != test.want {
+			t.Errorf("%s: String() got\n%s, want\n%s", test.name, got, test.want)
+		}
+	}
+}
+
+func TestDiffEntries_secret(t *testing.T) {
+	current := testSecretEntry{ID: ID{Title: "test"}}
+	current.Content.Value = attributes.NewExplicit("current")
+	current.Content.Password = "current-secret"
+
+	desired := testSecretEntry{ID: ID{Title: "test"}}
+	desired.Content.Value = attributes.NewExplicit("desired")
+	desired.Content.Password = "desired-secret"
+
+	changes, err := DiffEntries(current, desired)
+	if err != nil {
+		t.Fatalf("DiffEntries() returned error: %s", err)
+	}
+
+	want := []Change{
+		{Key: "password", Current: []string{"********"}, Desired: []string{"********"}, Redacted: true},
+		{Key: "value", Current: []string{"current"}, Desired: []string{"desired"}},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("DiffEntries() got\n%#v, want\n%#v", changes, want)
+	}
+
+	diff := EntryDiff{Exists: true, Changes: changes}
+	for _, output := range []string{diff.String(), fmt.Sprintf("%v", diff), fmt.Sprintf("%#v", changes)} {
+		if strings.Contains(output, "current-secret") || strings.Contains(output, "desired-secret") {
+			t.Errorf("diff output contains secret: %s", output)
+		}
+	}
+}
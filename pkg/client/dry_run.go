@@ -0,0 +1,166 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+// PlannedRequest is a request that was recorded by a DryRun instead of being sent.
+type PlannedRequest struct {
+	Method string
+	URL    string
+	Body   url.Values
+
+	// RawBody is the request body, if it is not url-encoded, such as a JSON body.
+	RawBody string
+}
+
+// String returns a human-readable representation of the PlannedRequest.
+func (planned PlannedRequest) String() string {
+	if planned.RawBody != "" {
+		return fmt.Sprintf("%s %s\n%s", planned.Method, planned.URL, planned.RawBody)
+	}
+
+	if len(planned.Body) == 0 {
+		return fmt.Sprintf("%s %s", planned.Method, planned.URL)
+	}
+
+	return fmt.Sprintf("%s %s\n%s", planned.Method, planned.URL, planned.Body.Encode())
+}
+
+// DryRun records requests that would modify the Splunk instance instead of sending them. Set a
+// Client's DryRun field to enable dry-run mode for that Client.
+//
+// Requests with the GET or HEAD methods are still sent, so operations that read state (such as
+// Apply and Diff) determine which changes would be made. Requests that modify state, including those
+// marked with BuildRequestDryRunRecorded such as Reload, are recorded, and their ResponseHandler is
+// not called. Requests marked with BuildRequestDryRunExempt, such as Password logins, are always
+// sent.
+type DryRun struct {
+	mu       sync.Mutex
+	requests []PlannedRequest
+}
+
+// Requests returns the PlannedRequests recorded so far, in the order they were made.
+func (dryRun *DryRun) Requests() []PlannedRequest {
+	dryRun.mu.Lock()
+	defer dryRun.mu.Unlock()
+
+	requests := make([]PlannedRequest, len(dryRun.requests))
+	copy(requests, dryRun.requests)
+
+	return requests
+}
+
+// Reset discards all recorded PlannedRequests.
+func (dryRun *DryRun) Reset() {
+	dryRun.mu.Lock()
+	defer dryRun.mu.Unlock()
+
+	dryRun.requests = nil
+}
+
+// record adds the given http.Request to the recorded PlannedRequests. The Authorization header
+// is not recorded. JSON bodies are recorded as RawBody, and all other bodies are parsed as url.Values,
+// with the values of redacted keys, such as those of attributes.Secret fields, masked.
+func (dryRun *DryRun) record(r *http.Request) error {
+	planned := PlannedRequest{
+		Method: r.Method,
+	}
+
+	if r.URL != nil {
+		planned.URL = r.URL.Redacted()
+	}
+
+	if r.Body != nil && r.Body != http.NoBody {
+		body, err := io.ReadAll(r.Body)
+		r.Body.Close()
+		if err != nil {
+			return wrapError(ErrorHTTPClient, err, "unable to read request body: %s", err)
+		}
+
+		if r.Header.Get("Content-Type") == "application/json" {
+			planned.RawBody = string(body)
+		} else {
+			planned.Body, err = url.ParseQuery(string(body))
+			if err != nil {
+				return wrapError(ErrorValues, err, "unable to parse request body: %s", err)
+			}
+
+			for key := range requestRedactedKeys(r) {
+				if _, ok := planned.Body[key]; ok {
+					planned.Body[key] = redactStrings(planned.Body[key])
+				}
+			}
+		}
+	}
+
+	dryRun.mu.Lock()
+	defer dryRun.mu.Unlock()
+
+	dryRun.requests = append(dryRun.requests, planned)
+
+	return nil
+}
+
+// dryRunExemptContextKey is the Context key used to mark a request as exempt from dry-run mode.
+type dryRunExemptContextKey struct{}
+
+// BuildRequestDryRunExempt returns a RequestBuilder that marks a request as exempt from dry-run mode,
+// causing it to be sent even if it would modify state. This is intended for requests that are needed
+// to perform other requests, such as authentication.
+func BuildRequestDryRunExempt() RequestBuilder {
+	return func(r *http.Request) error {
+		*r = *r.WithContext(context.WithValue(r.Context(), dryRunExemptContextKey{}, true))
+
+		return nil
+	}
+}
+
+// dryRunRecordedContextKey is the Context key used to mark a request as always recorded in dry-run mode.
+type dryRunRecordedContextKey struct{}
+
+// BuildRequestDryRunRecorded returns a RequestBuilder that marks a request as modifying state, causing
+// it to be recorded in dry-run mode even if its method, such as GET, would otherwise cause it to be
+// sent. This is intended for requests that change state despite their method, such as reloads.
+func BuildRequestDryRunRecorded() RequestBuilder {
+	return func(r *http.Request) error {
+		*r = *r.WithContext(context.WithValue(r.Context(), dryRunRecordedContextKey{}, true))
+
+		return nil
+	}
+}
+
+// requestDryRunRecorded returns true if the request should be recorded instead of sent in dry-run mode.
+func requestDryRunRecorded(r *http.Request) bool {
+	if recorded, _ := r.Context().Value(dryRunRecordedContextKey{}).(bool); recorded {
+		return true
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		return false
+	}
+
+	exempt, _ := r.Context().Value(dryRunExemptContextKey{}).(bool)
+
+	return !exempt
+}
@@ -0,0 +1,207 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+)
+
+func TestClient_DryRun(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputAction func(c *Client, entry testContentEntry) error
+		wantMethods []string
+		wantPlanned []PlannedRequest
+	}{
+		{
+			name: "create",
+			inputAction: func(c *Client, entry testContentEntry) error {
+				entry.ID.Title = "new"
+				return c.Create(entry)
+			},
+			wantPlanned: []PlannedRequest{
+				{
+					Method: http.MethodPost,
+					URL:    "/services/test/entries?output_mode=json",
+					Body:   url.Values{"name": []string{"new"}, "value": []string{"desired"}},
+				},
+			},
+		},
+		{
+			name: "update",
+			inputAction: func(c *Client, entry testContentEntry) error {
+				return c.Update(entry)
+			},
+			wantPlanned: []PlannedRequest{
+				{
+					Method: http.MethodPost,
+					URL:    "/services/test/entries/test?output_mode=json",
+					Body:   url.Values{"value": []string{"desired"}},
+				},
+			},
+		},
+		{
+			name: "delete",
+			inputAction: func(c *Client, entry testContentEntry) error {
+				return c.Delete(entry)
+			},
+			wantPlanned: []PlannedRequest{
+				{
+					Method: http.MethodDelete,
+					URL:    "/services/test/entries/test?output_mode=json",
+				},
+			},
+		},
+		{
+			name: "json body",
+			inputAction: func(c *Client, entry testContentEntry) error {
+				return c.RequestAndHandle(
+					ComposeRequestBuilder(
+						BuildRequestMethod(http.MethodPost),
+						BuildRequestEntryURL(c, entry),
+						BuildRequestBodyJSON(map[string]string{"value": "desired"}),
+					),
+					HandleResponseRequireCode(http.StatusOK, HandleResponseJSONMessagesError()),
+				)
+			},
+			wantPlanned: []PlannedRequest{
+				{
+					Method:  http.MethodPost,
+					URL:     "/services/test/entries/test",
+					RawBody: `{"value":"desired"}`,
+				},
+			},
+		},
+		{
+			name: "apply reads",
+			inputAction: func(c *Client, entry testContentEntry) error {
+				_, err := c.Apply(entry)
+				return err
+			},
+			wantMethods: []string{http.MethodGet},
+			wantPlanned: []PlannedRequest{
+				{
+					Method: http.MethodPost,
+					URL:    "/services/test/entries/test?output_mode=json",
+					Body:   url.Values{"value": []string{"desired"}},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		store := &testEntriesServer{entries: map[string]map[string]string{
+			"test": {"value": "current"},
+		}}
+		server := httptest.NewServer(store)
+		c := &Client{URL: server.URL, Authenticator: &testAuthenticator{}, DryRun: &DryRun{}}
+
+		entry := testContentEntry{ID: ID{Title: "test"}}
+		entry.Content.Value = attributes.NewExplicit("desired")
+
+		err := test.inputAction(c, entry)
+		server.Close()
+
+		if err != nil {
+			t.Errorf("%s: returned error: %s", test.name, err)
+			continue
+		}
+
+		if strings.Join(store.methods, ",") != strings.Join(test.wantMethods, ",") {
+			t.Errorf("%s: got methods %v, want %v", test.name, store.methods, test.wantMethods)
+		}
+
+		if store.entries["test"]["value"] != "current" {
+			t.Errorf("%s: entry was modified", test.name)
+		}
+
+		gotPlanned := c.DryRun.Requests()
+		for i := range gotPlanned {
+			gotPlanned[i].URL = strings.TrimPrefix(gotPlanned[i].URL, server.URL)
+		}
+
+		if !reflect.DeepEqual(gotPlanned, test.wantPlanned) {
+			t.Errorf("%s: Requests() got\n%#v, want\n%#v", test.name, gotPlanned, test.wantPlanned)
+		}
+	}
+}
+
+func TestClient_DryRun_secret(t *testing.T) {
+	server := httptest.NewServer(&testEntriesServer{entries: map[string]map[string]string{}})
+	defer server.Close()
+
+	c := &Client{URL: server.URL, Authenticator: &testAuthenticator{}, DryRun: &DryRun{}}
+
+	entry := testSecretEntry{ID: ID{Title: "test"}}
+	entry.Content.Value = attributes.NewExplicit("desired")
+	entry.Content.Password = "desired-secret"
+
+	if err := c.Update(entry); err != nil {
+		t.Fatalf("Update() returned error: %s", err)
+	}
+
+	gotPlanned := c.DryRun.Requests()
+	if len(gotPlanned) != 1 {
+		t.Fatalf("got %d planned requests, want 1", len(gotPlanned))
+	}
+
+	wantBody := url.Values{"value": []string{"desired"}, "password": []string{"********"}}
+	if !reflect.DeepEqual(gotPlanned[0].Body, wantBody) {
+		t.Errorf("got planned body\n%#v, want\n%#v", gotPlanned[0].Body, wantBody)
+	}
+
+	for _, output := range []string{gotPlanned[0].String(), fmt.Sprintf("%#v", gotPlanned[0])} {
+		if strings.Contains(output, "desired-secret") {
+			t.Errorf("planned request output contains secret: %s", output)
+		}
+	}
+}
+
+func TestClient_DryRun_reload(t *testing.T) {
+	var gotSent bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotSent = true
+	}))
+	defer server.Close()
+
+	c := &Client{URL: server.URL, Authenticator: &testAuthenticator{}, DryRun: &DryRun{}}
+
+	if err := c.Reload(testEntry{ID: ID{Namespace: Namespace{User: "nobody", App: "search"}}}); err != nil {
+		t.Fatalf("Reload() returned error: %s", err)
+	}
+
+	if gotSent {
+		t.Errorf("Reload() sent request in dry-run mode")
+	}
+
+	gotPlanned := c.DryRun.Requests()
+	wantPlanned := []PlannedRequest{
+		{
+			Method: http.MethodGet,
+			URL:    server.URL + "/servicesNS/nobody/search/test/entries/_reload?output_mode=json",
+		},
+	}
+	if !reflect.DeepEqual(gotPlanned, wantPlanned) {
+		t.Errorf("Reload() got planned\n%#v, want\n%#v", gotPlanned, wantPlanned)
+	}
+}
@@ -16,7 +16,10 @@ package client
 
 import (
 	"fmt"
+	"net/http"
 	"runtime/debug"
+
+	"github.com/splunk/go-splunk-client/pkg/messages"
 )
 
 // ErrorCode identifies the type of error that was encountered.
@@ -80,23 +83,82 @@ const (
 
 	// ErrorSharing indicates an error was encountered related to a Sharing value.
 	ErrorSharing
+
+	// ErrorListOptions indicates an invalid ListOptions configuration.
+	ErrorListOptions
+
+	// ErrorAlreadyExists indicates an attempt was made to create an object that already exists.
+	ErrorAlreadyExists
+
+	// ErrorPermissionDenied indicates a request was authenticated, but not permitted.
+	ErrorPermissionDenied
+
+	// ErrorJSON indicates an error was encountered while trying to encode to JSON.
+	ErrorJSON
 )
 
+// Sentinel Errors can be used with errors.Is to determine if a returned error is of a given kind.
+// An Error matches a sentinel if it has the same Code, or if it was caused by a response with
+// the StatusCode associated with the sentinel's Code.
+//
+//	if err := c.Create(entry); errors.Is(err, client.ErrAlreadyExists) {
+//		// update instead
+//	}
+var (
+	// ErrNotFound matches errors caused by an object not being found (404 Not Found).
+	ErrNotFound = Error{Code: ErrorNotFound, Message: "client: not found"}
+
+	// ErrAlreadyExists matches errors caused by an object already existing (409 Conflict).
+	ErrAlreadyExists = Error{Code: ErrorAlreadyExists, Message: "client: already exists"}
+
+	// ErrUnauthorized matches errors caused by a request being unauthorized (401 Unauthorized).
+	ErrUnauthorized = Error{Code: ErrorUnauthorized, Message: "client: unauthorized"}
+
+	// ErrPermissionDenied matches errors caused by a request being forbidden (403 Forbidden).
+	ErrPermissionDenied = Error{Code: ErrorPermissionDenied, Message: "client: permission denied"}
+)
+
+// statusCodeErrorCodes maps response status codes to the ErrorCode they indicate.
+var statusCodeErrorCodes = map[int]ErrorCode{
+	http.StatusUnauthorized: ErrorUnauthorized,
+	http.StatusForbidden:    ErrorPermissionDenied,
+	http.StatusNotFound:     ErrorNotFound,
+	http.StatusConflict:     ErrorAlreadyExists,
+}
+
 // Error represents an encountered error. It adheres to the "error" interface,
 // so will be returned as a standard error.
 //
 // Returned errors can be handled as this Error type:
 //
-//   if err := c.RequestAndHandle(...); err != nil {
-// 	  if clientErr, ok := err.(client.Error) {
-// 		  // check clientErr.Code to determine appropriate action
-// 	  }
-//   }
+//	var clientErr client.Error
+//	if errors.As(err, &clientErr) {
+//		// check clientErr.Code or clientErr.StatusCode to determine appropriate action
+//	}
+//
+// Or compared against the sentinel Errors with errors.Is:
+//
+//	if errors.Is(err, client.ErrNotFound) {
+//		// handle missing object
+//	}
 type Error struct {
 	Code       ErrorCode
 	Message    string
 	Wrapped    error
 	StackTrace string
+
+	// StatusCode is the status code of the http.Response that caused the error, if any.
+	StatusCode int
+
+	// Method is the method of the http.Request that caused the error, if any.
+	Method string
+
+	// URL is the URL of the http.Request that caused the error, if any. Any password
+	// present in the URL is redacted.
+	URL string
+
+	// Messages are the messages returned by the Splunk REST API, if any.
+	Messages messages.Messages
 }
 
 // Wrap returns a new Error with the given code, error, and message. The error value
@@ -110,7 +172,47 @@ func wrapError(code ErrorCode, err error, messagef string, messageArgs ...interf
 	}
 }
 
+// wrapResponseError returns a new Error with the given code, error, and message, with details
+// of the http.Response and its http.Request.
+func wrapResponseError(code ErrorCode, r *http.Response, msgs messages.Messages, err error, messagef string, messageArgs ...interface{}) Error {
+	newErr := wrapError(code, err, messagef, messageArgs...)
+	newErr.StatusCode = r.StatusCode
+	newErr.Messages = msgs
+
+	if r.Request != nil {
+		newErr.Method = r.Request.Method
+
+		if r.Request.URL != nil {
+			newErr.URL = r.Request.URL.Redacted()
+		}
+	}
+
+	return newErr
+}
+
 // Error returns the Error's message.
 func (err Error) Error() string {
 	return err.Message
 }
+
+// Unwrap returns the wrapped error, if any.
+func (err Error) Unwrap() error {
+	return err.Wrapped
+}
+
+// Is returns true if target is an Error with a defined Code that matches either this Error's Code,
+// or the ErrorCode indicated by this Error's StatusCode.
+func (err Error) Is(target error) bool {
+	targetErr, ok := target.(Error)
+	if !ok || targetErr.Code == ErrorUndefined {
+		return false
+	}
+
+	if err.Code == targetErr.Code {
+		return true
+	}
+
+	statusErrorCode, ok := statusCodeErrorCodes[err.StatusCode]
+
+	return ok && statusErrorCode == targetErr.Code
+}
@@ -54,6 +54,7 @@ func HandleResponseXML(i interface{}) ResponseHandler {
 
 // HandleResponseXMLMessagesCustomError returns a ResponseHandler that decode's an http.Response's
 // Body as an XML document of Messages and returns the Messages as an error with the given ErrorCode.
+// The returned Error includes the response's StatusCode and the request's Method and URL.
 func HandleResponseXMLMessagesCustomError(code ErrorCode) ResponseHandler {
 	return func(r *http.Response) error {
 		response := struct {
@@ -61,10 +62,10 @@ func HandleResponseXMLMessagesCustomError(code ErrorCode) ResponseHandler {
 		}{}
 
 		if err := HandleResponseXML(&response)(r); err != nil {
-			return err
+			return wrapResponseError(code, r, messages.Messages{}, err, "unable to decode messages from response with status %s: %s", r.Status, err)
 		}
 
-		return wrapError(code, nil, "response contained message: %s", response.Messages.String())
+		return wrapResponseError(code, r, response.Messages, nil, "response contained message: %s", response.Messages.String())
 	}
 }
 
@@ -90,14 +91,15 @@ func HandleResponseJSON(i interface{}) ResponseHandler {
 
 // HandleResponseJSONMessagesCustomError returns a ResponseHandler that decodes an http.Response's
 // Body as JSON document of Messages and returns the Messages as an error with the given ErrorCode.
+// The returned Error includes the response's StatusCode and the request's Method and URL.
 func HandleResponseJSONMessagesCustomError(code ErrorCode) ResponseHandler {
 	return func(r *http.Response) error {
 		msg := messages.Messages{}
 		if err := HandleResponseJSON(&msg)(r); err != nil {
-			return err
+			return wrapResponseError(code, r, messages.Messages{}, err, "unable to decode messages from response with status %s: %s", r.Status, err)
 		}
 
-		return wrapError(code, nil, "response contained message: %s", msg.String())
+		return wrapResponseError(code, r, msg, nil, "response contained message: %s", msg.String())
 	}
 }
 
@@ -137,6 +139,13 @@ func HandleResponseRequireCode(code int, errorResponseHandler ResponseHandler) R
 // HandleResponseEntries returns a ResponseHandler that parses the http.Response Body
 // into the list of Entry reference provided.
 func HandleResponseEntries(entries interface{}) ResponseHandler {
+	return HandleResponseEntriesPaging(entries, nil)
+}
+
+// HandleResponseEntriesPaging returns a ResponseHandler that parses the http.Response Body
+// into the list of Entry reference provided, and its paging information into the Paging reference
+// provided. paging may be nil if the paging information isn't needed.
+func HandleResponseEntriesPaging(entries interface{}, paging *Paging) ResponseHandler {
 	return func(r *http.Response) error {
 		entriesPtrV := reflect.ValueOf(entries)
 		if entriesPtrV.Kind() != reflect.Ptr {
@@ -153,6 +162,10 @@ func HandleResponseEntries(entries interface{}) ResponseHandler {
 				Name: "Entry",
 				Type: entriesV.Type(),
 			},
+			{
+				Name: "Paging",
+				Type: reflect.TypeOf(Paging{}),
+			},
 		})
 
 		entriesResponsePtrV := reflect.New(responseT)
@@ -168,6 +181,10 @@ func HandleResponseEntries(entries interface{}) ResponseHandler {
 
 		entriesV.Set(responseEntriesFieldV)
 
+		if paging != nil {
+			*paging = entriesResponseV.FieldByName("Paging").Interface().(Paging)
+		}
+
 		return nil
 	}
 }
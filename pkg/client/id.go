@@ -33,7 +33,9 @@ type ID struct {
 	url string
 }
 
-// ParseID returns a new ID by parsing the ID URL string.
+// ParseID returns a new ID by parsing the ID URL string. The Title is unescaped, so that titles
+// containing characters such as "/" are returned as they were named. A Title that isn't a valid
+// escaped path segment, such as one containing a lone "%", is used as-is.
 func ParseID(idURL string) (ID, error) {
 	newNS, remnants, err := parseNamespace(idURL)
 	if err != nil {
@@ -44,9 +46,14 @@ func ParseID(idURL string) (ID, error) {
 		return ID{}, wrapError(ErrorID, nil, "client: parseNamespace didn't return a remnant for ID.Title")
 	}
 
+	title := remnants[len(remnants)-1]
+	if unescapedTitle, err := url.PathUnescape(title); err == nil {
+		title = unescapedTitle
+	}
+
 	return ID{
 		Namespace: newNS,
-		Title:     remnants[len(remnants)-1],
+		Title:     title,
 		url:       idURL,
 	}, nil
 }
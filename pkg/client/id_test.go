@@ -49,6 +49,43 @@ func Test_ParseID(t *testing.T) {
 				url:   "https://localhost:8089/servicesNS/nobody/search/saved/searches/testsearch",
 			},
 		},
+		{
+			name:    "escaped title",
+			inputID: "https://localhost:8089/servicesNS/nobody/splunk_httpinput/data/inputs/http/http%3A%2F%2Ftesttoken",
+			wantID: ID{
+				Namespace: Namespace{
+					User: "nobody",
+					App:  "splunk_httpinput",
+				},
+				Title: "http://testtoken",
+				url:   "https://localhost:8089/servicesNS/nobody/splunk_httpinput/data/inputs/http/http%3A%2F%2Ftesttoken",
+			},
+		},
+		{
+			name:    "already unescaped title",
+			inputID: "https://localhost:8089/servicesNS/nobody/search/saved/searches/test search",
+			wantID: ID{
+				Namespace: Namespace{
+					User: "nobody",
+					App:  "search",
+				},
+				Title: "test search",
+				url:   "https://localhost:8089/servicesNS/nobody/search/saved/searches/test search",
+			},
+		},
+		{
+			name:    "title with invalid escape",
+			inputID: "https://localhost:8089/servicesNS/nobody/search/saved/searches/100%",
+			wantID: ID{
+				Namespace: Namespace{
+					User: "nobody",
+					App:  "search",
+				},
+				// the Title isn't a valid escaped path segment, so it is used as-is
+				Title: "100%",
+				url:   "https://localhost:8089/servicesNS/nobody/search/saved/searches/100%",
+			},
+		},
 		{
 			name:      "minimal empty namespace",
 			inputID:   "services",
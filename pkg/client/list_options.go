@@ -0,0 +1,87 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+// SortDirection is the direction in which listed entries are sorted.
+type SortDirection string
+
+const (
+	SortDirectionUndefined  SortDirection = ""
+	SortDirectionAscending  SortDirection = "asc"
+	SortDirectionDescending SortDirection = "desc"
+)
+
+// SortMode is the method by which listed entries are sorted.
+type SortMode string
+
+const (
+	SortModeUndefined SortMode = ""
+	SortModeAuto      SortMode = "auto"
+	SortModeAlpha     SortMode = "alpha"
+	SortModeAlphaCase SortMode = "alpha_case"
+	SortModeNum       SortMode = "num"
+)
+
+// ListOptions configures the entries returned by List and Iterate operations. The zero value
+// returns all entries with the Splunk REST API's default sorting.
+type ListOptions struct {
+	// Search filters the returned entries. It may be free text matched against all fields, or
+	// field-specific, such as "name=my_search*".
+	Search string `values:"search,omitzero"`
+
+	// SortKey is the field by which entries are sorted.
+	SortKey string `values:"sort_key,omitzero"`
+
+	// SortDir is the direction in which entries are sorted.
+	SortDir SortDirection `values:"sort_dir,omitzero"`
+
+	// SortMode is the method by which entries are sorted.
+	SortMode SortMode `values:"sort_mode,omitzero"`
+
+	// Fields limits the content fields returned for each entry. Content fields not in this list
+	// will be left unset.
+	Fields []string `values:"f,omitzero"`
+
+	// Summarize requests summarized entries, which omit some content fields.
+	Summarize bool `values:"summarize,omitzero"`
+
+	// Count is the number of entries requested per page. If unspecified, defaults to 1000.
+	Count int `values:"-"`
+
+	// Offset is the index of the first entry returned.
+	Offset int `values:"-"`
+}
+
+// pageSize returns the configured Count, or its default.
+func (opts ListOptions) pageSize() int {
+	if opts.Count > 0 {
+		return opts.Count
+	}
+
+	return defaultPageSize
+}
+
+// listOptions returns the ListOptions from opts, or the zero value if opts is empty. An error
+// is returned if more than one ListOptions is given.
+func listOptions(opts []ListOptions) (ListOptions, error) {
+	switch len(opts) {
+	case 0:
+		return ListOptions{}, nil
+	case 1:
+		return opts[0], nil
+	default:
+		return ListOptions{}, wrapError(ErrorListOptions, nil, "client: at most one ListOptions may be given, got %d", len(opts))
+	}
+}
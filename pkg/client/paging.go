@@ -0,0 +1,181 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+
+	"github.com/splunk/go-splunk-client/pkg/deepset"
+)
+
+// defaultPageSize is the number of entries requested per page when listing entries.
+const defaultPageSize = 1000
+
+// Paging represents the paging information returned with a list of entries.
+type Paging struct {
+	Total   int `json:"total"`
+	PerPage int `json:"perPage"`
+	Offset  int `json:"offset"`
+}
+
+// pageQuery represents the query parameters that select a page of entries.
+type pageQuery struct {
+	Count  int `values:"count"`
+	Offset int `values:"offset"`
+}
+
+// EntryIterator iterates through the entries of a collection one page at a time. Each call to Next
+// requests the next page, populating the entries slice the EntryIterator was created for with only
+// that page's entries.
+//
+//	var searches []entry.SavedSearch
+//	iterator := c.Iterate(ctx, &searches)
+//	for iterator.Next() {
+//	    // searches contains the current page
+//	}
+//	if err := iterator.Err(); err != nil {
+//	    // handle error
+//	}
+type EntryIterator struct {
+	client   *Client
+	ctx      context.Context
+	entries  interface{}
+	entry    interface{}
+	url      RequestBuilder
+	opts     ListOptions
+	pageSize int
+	offset   int
+	paging   Paging
+	done     bool
+	err      error
+}
+
+// newEntryIterator returns a new EntryIterator for entries, which must be a pointer to a slice.
+// modifier, if not nil, is set on the slice's entry type to determine the collection's URL.
+func (client *Client) newEntryIterator(ctx context.Context, entries interface{}, modifier interface{}, opts []ListOptions) *EntryIterator {
+	iterator := &EntryIterator{
+		client:  client,
+		ctx:     ctx,
+		entries: entries,
+	}
+
+	listOpts, err := listOptions(opts)
+	if err != nil {
+		iterator.err = err
+		return iterator
+	}
+	iterator.opts = listOpts
+	iterator.pageSize = listOpts.pageSize()
+	iterator.offset = listOpts.Offset
+
+	entriesPtrV := reflect.ValueOf(entries)
+	if entriesPtrV.Kind() != reflect.Ptr {
+		iterator.err = wrapError(ErrorPtr, nil, "client: List attempted on on-pointer value")
+		return iterator
+	}
+
+	entriesV := reflect.Indirect(entriesPtrV)
+	if entriesV.Kind() != reflect.Slice {
+		iterator.err = wrapError(ErrorSlice, nil, "client: List attempted on non-slice value")
+		return iterator
+	}
+	entryT := entriesV.Type().Elem()
+	iterator.entry = reflect.New(entryT).Interface()
+	iterator.url = BuildRequestEntryURL(client, iterator.entry)
+
+	if modifier != nil {
+		if err := deepset.Set(iterator.entry, modifier); err != nil {
+			iterator.err = err
+		}
+	}
+
+	return iterator
+}
+
+// Iterate returns an EntryIterator for entries without any ID or Namespace context. entries must
+// be a pointer to a slice of an entry type. At most one ListOptions may be given.
+func (client *Client) Iterate(ctx context.Context, entries interface{}, opts ...ListOptions) *EntryIterator {
+	return client.newEntryIterator(ctx, entries, nil, opts)
+}
+
+// IterateNamespace returns an EntryIterator for entries for a Namespace. entries must be a pointer
+// to a slice of an entry type. At most one ListOptions may be given.
+func (client *Client) IterateNamespace(ctx context.Context, entries interface{}, ns Namespace, opts ...ListOptions) *EntryIterator {
+	return client.newEntryIterator(ctx, entries, ns, opts)
+}
+
+// IterateID returns an EntryIterator for entries for an ID. entries must be a pointer to a slice
+// of an entry type. At most one ListOptions may be given.
+func (client *Client) IterateID(ctx context.Context, entries interface{}, id ID, opts ...ListOptions) *EntryIterator {
+	return client.newEntryIterator(ctx, entries, id, opts)
+}
+
+// IterateEntryAction returns an EntryIterator for entries listed by an action or sub-resource of
+// entry, such as a saved search's history. entries must be a pointer to a slice of an entry type.
+// At most one ListOptions may be given.
+func (client *Client) IterateEntryAction(ctx context.Context, entries interface{}, entry interface{}, action string, opts ...ListOptions) *EntryIterator {
+	iterator := client.newEntryIterator(ctx, entries, nil, opts)
+	iterator.url = BuildRequestEntryActionURL(client, entry, action)
+
+	return iterator
+}
+
+// Next requests the next page of entries. It returns false when there are no more entries or an
+// error was encountered, which can be checked with Err.
+func (iterator *EntryIterator) Next() bool {
+	if iterator.done || iterator.err != nil {
+		return false
+	}
+
+	if err := iterator.client.RequestAndHandleContext(
+		iterator.ctx,
+		ComposeRequestBuilder(
+			BuildRequestMethod(http.MethodGet),
+			iterator.url,
+			BuildRequestOutputModeJSON(),
+			BuildRequestQueryValues(iterator.opts),
+			BuildRequestQueryValues(pageQuery{Count: iterator.pageSize, Offset: iterator.offset}),
+			BuildRequestAuthenticate(iterator.client),
+		),
+		ComposeResponseHandler(
+			HandleResponseRequireCode(http.StatusOK, HandleResponseJSONMessagesError()),
+			HandleResponseEntriesPaging(iterator.entries, &iterator.paging),
+		),
+	); err != nil {
+		iterator.err = err
+		return false
+	}
+
+	pageLen := reflect.Indirect(reflect.ValueOf(iterator.entries)).Len()
+	iterator.offset += pageLen
+
+	if pageLen == 0 || iterator.offset >= iterator.paging.Total {
+		iterator.done = true
+	}
+
+	return pageLen > 0
+}
+
+// Err returns the error encountered by Next, if any.
+func (iterator *EntryIterator) Err() error {
+	return iterator.err
+}
+
+// Paging returns the paging information of the most recently requested page.
+func (iterator *EntryIterator) Paging() Paging {
+	return iterator.paging
+}
@@ -0,0 +1,262 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+)
+
+// testEntry is a minimal entry type for testing Client operations.
+type testEntry struct {
+	ID ID `service:"test/entries" selective:"create"`
+}
+
+// newTestPagingServer returns an httptest.Server that serves total entries from the test/entries
+// collection, honoring the count and offset query parameters.
+func newTestPagingServer(total int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		count, _ := strconv.Atoi(r.URL.Query().Get("count"))
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		type responseEntry struct {
+			ID string `json:"id"`
+		}
+
+		response := struct {
+			Paging Paging          `json:"paging"`
+			Entry  []responseEntry `json:"entry"`
+		}{
+			Paging: Paging{Total: total, PerPage: count, Offset: offset},
+			Entry:  []responseEntry{},
+		}
+
+		for i := offset; i < total && (count == 0 || i < offset+count); i++ {
+			response.Entry = append(response.Entry, responseEntry{ID: fmt.Sprintf("https://localhost:8089/services/test/entries/entry%d", i)})
+		}
+
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+}
+
+func TestClient_List_paging(t *testing.T) {
+	tests := []struct {
+		name      string
+		inputSize int
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:      "single page",
+			inputSize: 10,
+		},
+		{
+			name:      "exactly one page",
+			inputSize: defaultPageSize,
+		},
+		{
+			name:      "multiple pages",
+			inputSize: defaultPageSize*2 + 5,
+		},
+	}
+
+	for _, test := range tests {
+		server := newTestPagingServer(test.inputSize)
+		c := &Client{URL: server.URL, Authenticator: &testAuthenticator{}}
+
+		var entries []testEntry
+		err := c.List(&entries)
+		server.Close()
+
+		if err != nil {
+			t.Errorf("%s: List() returned error: %s", test.name, err)
+		}
+
+		if len(entries) != test.inputSize {
+			t.Errorf("%s: List() got %d entries, want %d", test.name, len(entries), test.inputSize)
+		}
+
+		for i, entry := range entries {
+			if wantTitle := fmt.Sprintf("entry%d", i); entry.ID.Title != wantTitle {
+				t.Errorf("%s: List() got entry %d title %s, want %s", test.name, i, entry.ID.Title, wantTitle)
+				break
+			}
+		}
+	}
+}
+
+func TestEntryIterator_Next(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputSize     int
+		inputPageSize int
+		wantPageSizes []int
+	}{
+		{
+			name:          "empty",
+			inputPageSize: 10,
+		},
+		{
+			name:          "partial page",
+			inputSize:     5,
+			inputPageSize: 10,
+			wantPageSizes: []int{5},
+		},
+		{
+			name:          "full pages",
+			inputSize:     20,
+			inputPageSize: 10,
+			wantPageSizes: []int{10, 10},
+		},
+		{
+			name:          "remainder",
+			inputSize:     25,
+			inputPageSize: 10,
+			wantPageSizes: []int{10, 10, 5},
+		},
+	}
+
+	for _, test := range tests {
+		server := newTestPagingServer(test.inputSize)
+		c := &Client{URL: server.URL, Authenticator: &testAuthenticator{}}
+
+		var entries []testEntry
+		var gotPageSizes []int
+
+		iterator := c.Iterate(context.Background(), &entries)
+		iterator.pageSize = test.inputPageSize
+		for iterator.Next() {
+			gotPageSizes = append(gotPageSizes, len(entries))
+		}
+		server.Close()
+
+		if err := iterator.Err(); err != nil {
+			t.Errorf("%s: Next() returned error: %s", test.name, err)
+		}
+
+		if fmt.Sprint(gotPageSizes) != fmt.Sprint(test.wantPageSizes) {
+			t.Errorf("%s: Next() got page sizes %v, want %v", test.name, gotPageSizes, test.wantPageSizes)
+		}
+	}
+}
+
+func TestClient_ListContext_options(t *testing.T) {
+	tests := []struct {
+		name      string
+		inputOpts []ListOptions
+		wantQuery map[string][]string
+		wantError bool
+	}{
+		{
+			name: "none",
+			wantQuery: map[string][]string{
+				"output_mode": {"json"},
+				"count":       {strconv.Itoa(defaultPageSize)},
+				"offset":      {"0"},
+			},
+		},
+		{
+			name: "all options",
+			inputOpts: []ListOptions{
+				{
+					Search:    "name=test*",
+					SortKey:   "name",
+					SortDir:   SortDirectionDescending,
+					SortMode:  SortModeAlpha,
+					Fields:    []string{"search", "disabled"},
+					Summarize: true,
+					Count:     10,
+					Offset:    5,
+				},
+			},
+			wantQuery: map[string][]string{
+				"output_mode": {"json"},
+				"search":      {"name=test*"},
+				"sort_key":    {"name"},
+				"sort_dir":    {"desc"},
+				"sort_mode":   {"alpha"},
+				"f":           {"search", "disabled"},
+				"summarize":   {"true"},
+				"count":       {"10"},
+				"offset":      {"5"},
+			},
+		},
+		{
+			name:      "multiple options",
+			inputOpts: []ListOptions{{}, {}},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		var gotQuery map[string][]string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotQuery = r.URL.Query()
+
+			_, _ = w.Write([]byte(`{"paging":{"total":0},"entry":[]}`))
+		}))
+		c := &Client{URL: server.URL, Authenticator: &testAuthenticator{}}
+
+		var entries []testEntry
+		err := c.ListContext(context.Background(), &entries, test.inputOpts...)
+		gotError := err != nil
+		server.Close()
+
+		if gotError != test.wantError {
+			t.Errorf("%s: ListContext() returned error? %v (%s)", test.name, gotError, err)
+		}
+
+		if fmt.Sprint(gotQuery) != fmt.Sprint(test.wantQuery) {
+			t.Errorf("%s: ListContext() got query\n%#v, want\n%#v", test.name, gotQuery, test.wantQuery)
+		}
+	}
+}
+
+func TestClient_ListEntryAction(t *testing.T) {
+	var gotPaths []string
+
+	pagingServer := newTestPagingServer(25)
+	defer pagingServer.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPaths = append(gotPaths, r.URL.Path)
+
+		pagingServer.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer server.Close()
+
+	c := &Client{URL: server.URL, Authenticator: &testAuthenticator{}}
+
+	var entries []testEntry
+	if err := c.ListEntryAction(&entries, testEntry{ID: ID{Title: "parent"}}, "members", ListOptions{Count: 10}); err != nil {
+		t.Fatalf("ListEntryAction() returned error: %s", err)
+	}
+
+	if len(entries) != 25 {
+		t.Errorf("ListEntryAction() got %d entries, want %d", len(entries), 25)
+	}
+
+	wantPaths := []string{"/services/test/entries/parent/members", "/services/test/entries/parent/members", "/services/test/entries/parent/members"}
+	if fmt.Sprint(gotPaths) != fmt.Sprint(wantPaths) {
+		t.Errorf("ListEntryAction() got paths %v, want %v", gotPaths, wantPaths)
+	}
+}
@@ -0,0 +1,155 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/splunk/go-splunk-client/pkg/internal/paths"
+)
+
+// PasswordID represents the ID of storage/passwords resources, which are titled in the format
+// "realm:username:". Colons in the Realm or Username are escaped with a backslash in the title.
+type PasswordID struct {
+	Namespace Namespace
+
+	Realm    string
+	Username string
+}
+
+// passwordTitleEscaper escapes the characters that have special meaning in a storage/passwords title.
+var passwordTitleEscaper = strings.NewReplacer(`\`, `\\`, `:`, `\:`)
+
+// escapePasswordTitleComponent returns s with backslashes and colons escaped.
+func escapePasswordTitleComponent(s string) string {
+	return passwordTitleEscaper.Replace(s)
+}
+
+// splitPasswordTitle splits a storage/passwords title on its unescaped colons, unescaping each component.
+func splitPasswordTitle(title string) []string {
+	var components []string
+	var current strings.Builder
+
+	for i := 0; i < len(title); i++ {
+		switch {
+		case title[i] == '\\' && i+1 < len(title):
+			i++
+			current.WriteByte(title[i])
+		case title[i] == ':':
+			components = append(components, current.String())
+			current.Reset()
+		default:
+			current.WriteByte(title[i])
+		}
+	}
+
+	return append(components, current.String())
+}
+
+// parsePasswordID returns a new PasswordID by parsing the ID URL string.
+func parsePasswordID(idURL string) (PasswordID, error) {
+	newNS, remnants, err := parseNamespace(idURL)
+	if err != nil {
+		return PasswordID{}, err
+	}
+
+	if len(remnants) < 1 {
+		return PasswordID{}, wrapError(ErrorID, nil, "client: parseNamespace didn't return a remnant for PasswordID")
+	}
+
+	title, err := url.PathUnescape(remnants[len(remnants)-1])
+	if err != nil {
+		return PasswordID{}, wrapError(ErrorID, err, "client: unable to unescape PasswordID title: %s", err)
+	}
+
+	// the title ends with a colon, resulting in an empty final component
+	components := splitPasswordTitle(title)
+	if len(components) != 3 || components[2] != "" {
+		return PasswordID{}, wrapError(ErrorID, nil, "client: unable to parse %q as realm:username:", title)
+	}
+
+	return PasswordID{
+		Namespace: newNS,
+		Realm:     components[0],
+		Username:  components[1],
+	}, nil
+}
+
+// Parse sets the ID's value to match what is parsed from the given ID URL.
+func (passwordID *PasswordID) Parse(idURL string) error {
+	newPasswordID, err := parsePasswordID(idURL)
+	if err != nil {
+		return err
+	}
+
+	*passwordID = newPasswordID
+
+	return nil
+}
+
+// Title returns the PasswordID's title, in the format "realm:username:".
+func (passwordID PasswordID) Title() string {
+	return escapePasswordTitleComponent(passwordID.Realm) + ":" + escapePasswordTitleComponent(passwordID.Username) + ":"
+}
+
+// GetServicePath implements custom GetServicePath encoding. It returns its Namespace's
+// service path.
+func (passwordID PasswordID) GetServicePath(path string) (string, error) {
+	return passwordID.Namespace.GetServicePath(path)
+}
+
+// GetEntryPath implements custom GetEntryPath encoding. It returns the url-encoded
+// value of the PasswordID's Title with the service path preceding it.
+func (passwordID PasswordID) GetEntryPath(path string) (string, error) {
+	if passwordID.Username == "" {
+		return "", wrapError(ErrorID, nil, "client: attempted PasswordID.GetEntryPath() with empty Username")
+	}
+
+	servicePath, err := passwordID.GetServicePath(path)
+	if err != nil {
+		return "", err
+	}
+
+	return paths.Join(servicePath, url.PathEscape(passwordID.Title())), nil
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling for PasswordID.
+func (passwordID *PasswordID) UnmarshalJSON(data []byte) error {
+	idString := ""
+	if err := json.Unmarshal(data, &idString); err != nil {
+		return wrapError(ErrorID, err, "client: unable to unmarshal %q as string", data)
+	}
+
+	return passwordID.Parse(idString)
+}
+
+// SetURLValues implements custom url.Query encoding of PasswordID. It adds a field "name" for the
+// PasswordID's Username, and a field "realm" for its Realm if it is not empty. If the Username is
+// empty, it returns an error.
+func (passwordID PasswordID) SetURLValues(key string, v *url.Values) error {
+	if passwordID.Username == "" {
+		return wrapError(ErrorID, nil, "client: attempted SetURLValues on PasswordID with empty Username")
+	}
+
+	v.Add("name", passwordID.Username)
+
+	if passwordID.Realm != "" {
+		v.Add("realm", passwordID.Realm)
+	}
+
+	return nil
+}
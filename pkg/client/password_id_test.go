@@ -0,0 +1,144 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+)
+
+func Test_parsePasswordID(t *testing.T) {
+	tests := []struct {
+		name           string
+		input          string
+		wantPasswordID PasswordID
+		wantError      bool
+	}{
+		{
+			name:      "empty",
+			wantError: true,
+		},
+		{
+			name:      "malformed, missing trailing colon",
+			input:     "/servicesNS/nobody/search/storage/passwords/realm%3Auser",
+			wantError: true,
+		},
+		{
+			name:  "valid",
+			input: "/servicesNS/nobody/search/storage/passwords/realm%3Auser%3A",
+			wantPasswordID: PasswordID{
+				Namespace: Namespace{User: "nobody", App: "search"},
+				Realm:     "realm",
+				Username:  "user",
+			},
+		},
+		{
+			name:  "empty realm",
+			input: "/services/storage/passwords/%3Auser%3A",
+			wantPasswordID: PasswordID{
+				Username: "user",
+			},
+		},
+		{
+			name:  "escaped colons",
+			input: "/services/storage/passwords/https%5C%3A%2F%2Fexample.com%3Adomain%5C%3Auser%3A",
+			wantPasswordID: PasswordID{
+				Realm:    "https://example.com",
+				Username: "domain:user",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		gotPasswordID, err := parsePasswordID(test.input)
+		gotError := err != nil
+
+		if gotError != test.wantError {
+			t.Errorf("%s: parsePasswordID() returned error? %v (%s)", test.name, gotError, err)
+		}
+
+		if gotPasswordID != test.wantPasswordID {
+			t.Errorf("%s: parsePasswordID() got\n%#v, want\n%#v", test.name, gotPasswordID, test.wantPasswordID)
+		}
+	}
+}
+
+func TestPasswordID_Paths(t *testing.T) {
+	tests := []struct {
+		name               string
+		input              PasswordID
+		wantTitle          string
+		wantEntryPath      string
+		wantEntryPathError bool
+		wantValues         url.Values
+		wantValuesError    bool
+	}{
+		{
+			name:               "empty",
+			wantTitle:          "::",
+			wantEntryPathError: true,
+			wantValues:         url.Values{},
+			wantValuesError:    true,
+		},
+		{
+			name:          "without realm",
+			input:         PasswordID{Username: "user"},
+			wantTitle:     ":user:",
+			wantEntryPath: "services/storage/passwords/:user:",
+			wantValues:    url.Values{"name": []string{"user"}},
+		},
+		{
+			name: "escaped colons",
+			input: PasswordID{
+				Namespace: Namespace{User: "nobody", App: "search"},
+				Realm:     "https://example.com",
+				Username:  "domain:user",
+			},
+			wantTitle:     `https\://example.com:domain\:user:`,
+			wantEntryPath: "servicesNS/nobody/search/storage/passwords/https%5C:%2F%2Fexample.com:domain%5C:user:",
+			wantValues:    url.Values{"name": []string{"domain:user"}, "realm": []string{"https://example.com"}},
+		},
+	}
+
+	for _, test := range tests {
+		if gotTitle := test.input.Title(); gotTitle != test.wantTitle {
+			t.Errorf("%s: Title() got %s, want %s", test.name, gotTitle, test.wantTitle)
+		}
+
+		gotEntryPath, err := test.input.GetEntryPath("storage/passwords")
+		gotEntryPathError := err != nil
+
+		if gotEntryPathError != test.wantEntryPathError {
+			t.Errorf("%s: GetEntryPath() returned error? %v (%s)", test.name, gotEntryPathError, err)
+		}
+
+		if gotEntryPath != test.wantEntryPath {
+			t.Errorf("%s: GetEntryPath() got\n%s, want\n%s", test.name, gotEntryPath, test.wantEntryPath)
+		}
+
+		gotValues := url.Values{}
+		err = test.input.SetURLValues("", &gotValues)
+		gotValuesError := err != nil
+
+		if gotValuesError != test.wantValuesError {
+			t.Errorf("%s: SetURLValues() returned error? %v (%s)", test.name, gotValuesError, err)
+		}
+
+		if !reflect.DeepEqual(gotValues, test.wantValues) {
+			t.Errorf("%s: SetURLValues() got\n%#v, want\n%#v", test.name, gotValues, test.wantValues)
+		}
+	}
+}
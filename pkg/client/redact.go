@@ -0,0 +1,60 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// redactedValue replaces the values of redacted keys in human-readable output.
+const redactedValue = "********"
+
+// redactedKeysContextKey is the Context key used to store the url.Values keys of a request body
+// that must be redacted.
+type redactedKeysContextKey struct{}
+
+// withRedactedKeys returns a copy of r with redactedKeys stored in its Context. r is returned as-is
+// if there are no redactedKeys.
+func withRedactedKeys(r *http.Request, redactedKeys map[string]bool) *http.Request {
+	if len(redactedKeys) == 0 {
+		return r
+	}
+
+	return r.WithContext(context.WithValue(r.Context(), redactedKeysContextKey{}, redactedKeys))
+}
+
+// requestRedactedKeys returns the url.Values keys of r's body that must be redacted.
+func requestRedactedKeys(r *http.Request) map[string]bool {
+	redactedKeys, _ := r.Context().Value(redactedKeysContextKey{}).(map[string]bool)
+
+	return redactedKeys
+}
+
+// redactStrings returns a copy of v with each non-empty value replaced by redactedValue.
+func redactStrings(v []string) []string {
+	if v == nil {
+		return nil
+	}
+
+	redacted := make([]string, len(v))
+	for i, value := range v {
+		if value != "" {
+			redacted[i] = redactedValue
+		}
+	}
+
+	return redacted
+}
@@ -0,0 +1,127 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+)
+
+// serverControl is used to build the path to the server/control endpoint.
+type serverControl struct {
+	_ Namespace `service:"server/control"`
+}
+
+// serverInfo is the subset of server/info used to determine when splunkd has restarted.
+type serverInfo struct {
+	ID      SingletonID `service:"server/info"`
+	Content struct {
+		StartupTime int `json:"startup_time"`
+	} `json:"content"`
+}
+
+// Restart restarts splunkd. It returns once the restart has been requested, which is before
+// splunkd has stopped. Use RestartAndWait to wait for splunkd to become available again.
+func (client *Client) Restart() error {
+	return client.RestartContext(context.Background())
+}
+
+// RestartContext restarts splunkd, using the given Context.
+func (client *Client) RestartContext(ctx context.Context) error {
+	return client.RequestAndHandleContext(
+		ctx,
+		ComposeRequestBuilder(
+			BuildRequestMethod(http.MethodPost),
+			BuildRequestServiceActionURL(client, serverControl{}, "restart"),
+			BuildRequestOutputModeJSON(),
+			BuildRequestAuthenticate(client),
+		),
+		ComposeResponseHandler(
+			HandleResponseRequireCode(http.StatusOK, HandleResponseJSONMessagesError()),
+		),
+	)
+}
+
+// RestartAndWait restarts splunkd and waits for it to stop and become available again. It waits
+// indefinitely while splunkd is unavailable. Use RestartAndWaitContext to limit how long to wait.
+func (client *Client) RestartAndWait(pollInterval time.Duration) error {
+	return client.RestartAndWaitContext(context.Background(), pollInterval)
+}
+
+// RestartAndWaitContext restarts splunkd and waits for it to stop and become available again. It
+// polls server/info every pollInterval until it reports a new startup time, which happens only once
+// splunkd has stopped and started again. Transport errors and server error (5xx) responses are
+// expected while splunkd is unavailable and are ignored, so ctx should have a deadline to limit how
+// long to wait. Any other error, such as ErrUnauthorized or ErrPermissionDenied, is returned
+// immediately.
+//
+// If the Client's Authenticator is a Reauthenticator, such as authenticators.Password, its session is
+// re-established by the first poll after splunkd starts, as the previous session is no longer valid.
+//
+// In dry-run mode the restart is recorded and RestartAndWaitContext returns without waiting.
+func (client *Client) RestartAndWaitContext(ctx context.Context, pollInterval time.Duration) error {
+	before := serverInfo{}
+	if err := client.ReadContext(ctx, &before); err != nil {
+		return err
+	}
+
+	if err := client.RestartContext(ctx); err != nil {
+		return err
+	}
+
+	if client.DryRun != nil {
+		return nil
+	}
+
+	for {
+		timer := time.NewTimer(pollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		current := serverInfo{}
+		if err := client.ReadContext(ctx, &current); err != nil {
+			if splunkdUnavailable(err) {
+				continue
+			}
+
+			return err
+		}
+
+		if current.Content.StartupTime != before.Content.StartupTime {
+			return nil
+		}
+	}
+}
+
+// splunkdUnavailable returns true if err indicates that splunkd is stopping, stopped, or not yet ready,
+// which is the case for transport errors and server error (5xx) responses.
+func splunkdUnavailable(err error) bool {
+	if errors.Is(err, ErrUnauthorized) || errors.Is(err, ErrPermissionDenied) {
+		return false
+	}
+
+	var clientErr Error
+	if !errors.As(err, &clientErr) {
+		return false
+	}
+
+	return clientErr.Code == ErrorHTTPClient || clientErr.StatusCode >= http.StatusInternalServerError
+}
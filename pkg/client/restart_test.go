@@ -0,0 +1,220 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// testSessionAuthenticator is a Reauthenticator that authenticates requests with a session that is
+// replaced when rejected.
+type testSessionAuthenticator struct {
+	mu      sync.Mutex
+	session int
+}
+
+func (a *testSessionAuthenticator) AuthenticateRequest(c *Client, r *http.Request) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return BuildRequestHeader("Authorization", fmt.Sprintf("Splunk session%d", a.session))(r)
+}
+
+func (a *testSessionAuthenticator) Reauthenticate(c *Client, r *http.Request) (bool, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.session++
+
+	return true, nil
+}
+
+// testRestartServer is an http.Handler that simulates splunkd restarting. After a restart is requested,
+// server/info is unavailable for downPolls requests, after which it reports a new startup time and
+// only accepts a new session.
+type testRestartServer struct {
+	mu          sync.Mutex
+	downPolls   int
+	restarted   bool
+	startupTime int
+}
+
+func (server *testRestartServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	server.mu.Lock()
+	defer server.mu.Unlock()
+
+	switch r.URL.Path {
+	case "/services/server/control/restart":
+		server.restarted = true
+	case "/services/server/info":
+		if server.restarted {
+			if server.downPolls > 0 {
+				server.downPolls--
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			if r.Header.Get("Authorization") == "Splunk session0" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+
+			server.startupTime = 200
+		}
+
+		fmt.Fprintf(w, `{"entry":[{"id":"https://localhost:8089/services/server/info/server-info","content":{"startup_time":%d}}]}`, server.startupTime)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func TestClient_RestartAndWaitContext(t *testing.T) {
+	restartServer := &testRestartServer{downPolls: 3, startupTime: 100}
+	server := httptest.NewServer(restartServer)
+	defer server.Close()
+
+	authenticator := &testSessionAuthenticator{}
+	c := &Client{URL: server.URL, Authenticator: authenticator}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	if err := c.RestartAndWaitContext(ctx, time.Millisecond); err != nil {
+		t.Fatalf("RestartAndWaitContext() returned error: %s", err)
+	}
+
+	if !restartServer.restarted || restartServer.downPolls != 0 {
+		t.Errorf("RestartAndWaitContext() returned before restart completed")
+	}
+
+	if authenticator.session != 1 {
+		t.Errorf("RestartAndWaitContext() got session %d, want 1", authenticator.session)
+	}
+}
+
+func TestClient_RestartAndWait(t *testing.T) {
+	restartServer := &testRestartServer{downPolls: 3, startupTime: 100}
+	server := httptest.NewServer(restartServer)
+	defer server.Close()
+
+	c := &Client{URL: server.URL, Authenticator: &testSessionAuthenticator{}}
+
+	if err := c.RestartAndWait(time.Millisecond); err != nil {
+		t.Fatalf("RestartAndWait() returned error: %s", err)
+	}
+
+	if !restartServer.restarted || restartServer.downPolls != 0 {
+		t.Errorf("RestartAndWait() returned before restart completed")
+	}
+}
+
+func TestClient_RestartAndWaitContext_errors(t *testing.T) {
+	tests := []struct {
+		name          string
+		inputPollCode int
+		wantError     error
+	}{
+		{
+			name:          "unauthorized",
+			inputPollCode: http.StatusUnauthorized,
+			wantError:     ErrUnauthorized,
+		},
+		{
+			name:          "permission denied",
+			inputPollCode: http.StatusForbidden,
+			wantError:     ErrPermissionDenied,
+		},
+		{
+			name:          "not found",
+			inputPollCode: http.StatusNotFound,
+			wantError:     ErrNotFound,
+		},
+	}
+
+	for _, test := range tests {
+		var mu sync.Mutex
+		restarted := false
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if r.URL.Path == "/services/server/control/restart" {
+				restarted = true
+				return
+			}
+
+			if restarted {
+				w.WriteHeader(test.inputPollCode)
+				return
+			}
+
+			fmt.Fprint(w, `{"entry":[{"id":"https://localhost:8089/services/server/info/server-info","content":{"startup_time":100}}]}`)
+		}))
+		c := &Client{URL: server.URL, Authenticator: &testAuthenticator{}}
+
+		// the error is expected well before the deadline, which is only reached if the error is ignored
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+		err := c.RestartAndWaitContext(ctx, time.Millisecond)
+		cancel()
+		server.Close()
+
+		if !errors.Is(err, test.wantError) {
+			t.Errorf("%s: RestartAndWaitContext() got error %v, want %v", test.name, err, test.wantError)
+		}
+	}
+}
+
+func TestClient_RestartAndWaitContext_deadline(t *testing.T) {
+	// downPolls is never exhausted, so the server never becomes available again
+	server := httptest.NewServer(&testRestartServer{downPolls: 1 << 30, startupTime: 100})
+	defer server.Close()
+
+	c := &Client{URL: server.URL, Authenticator: &testAuthenticator{}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+
+	if err := c.RestartAndWaitContext(ctx, time.Millisecond); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("RestartAndWaitContext() got error %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestClient_RestartAndWaitContext_dryRun(t *testing.T) {
+	restartServer := &testRestartServer{startupTime: 100}
+	server := httptest.NewServer(restartServer)
+	defer server.Close()
+
+	c := &Client{URL: server.URL, Authenticator: &testAuthenticator{}, DryRun: &DryRun{}}
+
+	if err := c.RestartAndWaitContext(context.Background(), time.Millisecond); err != nil {
+		t.Fatalf("RestartAndWaitContext() returned error: %s", err)
+	}
+
+	if restartServer.restarted {
+		t.Errorf("RestartAndWaitContext() restarted in dry-run mode")
+	}
+
+	if got := len(c.DryRun.Requests()); got != 1 {
+		t.Errorf("RestartAndWaitContext() recorded %d requests, want 1", got)
+	}
+}
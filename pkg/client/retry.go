@@ -0,0 +1,257 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	defaultRetryInitialBackoff = time.Millisecond * 500
+	defaultRetryMaxBackoff     = time.Second * 30
+)
+
+// defaultRetryableStatusCodes are the status codes that are retried if a RetryPolicy doesn't
+// define its own RetryableStatusCodes.
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy configures how a Client retries requests that fail with transient errors. The zero
+// value disables retries.
+//
+// Idempotent requests (GET, HEAD, OPTIONS, PUT and DELETE requests, or any request built with
+// BuildRequestIdempotent) are retried if they fail with a connection error or a retryable status code.
+// Non-idempotent requests, such as the POST request performed by Create, are only retried if the
+// connection to Splunk could not be established, because only then is it certain that the request
+// was never received.
+//
+// Requests with a Body are only retried if the Body can be replayed via the http.Request's GetBody
+// function, which is set by BuildRequestBodyValues.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts made for a request, including the first. A value
+	// less than 2 disables retries.
+	MaxAttempts int
+
+	// InitialBackoff is the maximum delay before the first retry. The maximum delay doubles for each
+	// subsequent retry, up to MaxBackoff. The actual delay is randomly chosen between zero and the
+	// maximum delay. If unspecified, defaults to 500 milliseconds.
+	InitialBackoff time.Duration
+
+	// MaxBackoff is the upper limit of the delay between attempts. If unspecified, defaults to 30 seconds.
+	MaxBackoff time.Duration
+
+	// RetryableStatusCodes are the response status codes that cause an idempotent request to be
+	// retried. If unspecified, defaults to 429, 502, 503 and 504.
+	RetryableStatusCodes []int
+
+	// RetryNonIdempotent permits non-idempotent requests to be retried under the same conditions
+	// as idempotent requests. Only set this if duplicate processing of a request is acceptable.
+	RetryNonIdempotent bool
+}
+
+// initialBackoff returns the configured InitialBackoff, or its default.
+func (policy RetryPolicy) initialBackoff() time.Duration {
+	if policy.InitialBackoff > 0 {
+		return policy.InitialBackoff
+	}
+
+	return defaultRetryInitialBackoff
+}
+
+// maxBackoff returns the configured MaxBackoff, or its default.
+func (policy RetryPolicy) maxBackoff() time.Duration {
+	if policy.MaxBackoff > 0 {
+		return policy.MaxBackoff
+	}
+
+	return defaultRetryMaxBackoff
+}
+
+// retryableStatusCode returns true if code is one of the policy's retryable status codes.
+func (policy RetryPolicy) retryableStatusCode(code int) bool {
+	codes := policy.RetryableStatusCodes
+	if codes == nil {
+		codes = defaultRetryableStatusCodes
+	}
+
+	for _, retryableCode := range codes {
+		if code == retryableCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+// shouldRetry returns true if the request should be attempted again, given the response or error
+// returned by the previous attempt.
+func (policy RetryPolicy) shouldRetry(attempt int, r *http.Request, resp *http.Response, err error) bool {
+	if attempt >= policy.MaxAttempts {
+		return false
+	}
+
+	if !requestReplayable(r) {
+		return false
+	}
+
+	if err != nil {
+		// the caller is no longer interested in the result
+		if r.Context().Err() != nil {
+			return false
+		}
+
+		// the request was never sent, so it is safe to retry regardless of idempotency
+		if isConnectError(err) {
+			return true
+		}
+
+		return policy.RetryNonIdempotent || requestIdempotent(r)
+	}
+
+	if !policy.retryableStatusCode(resp.StatusCode) {
+		return false
+	}
+
+	return policy.RetryNonIdempotent || requestIdempotent(r)
+}
+
+// backoff returns the delay to wait before the next attempt, given the number of attempts made so far
+// and the last response, if any. A Retry-After header (in seconds) is honored, up to MaxBackoff.
+func (policy RetryPolicy) backoff(attempt int, resp *http.Response) time.Duration {
+	maxBackoff := policy.maxBackoff()
+
+	if resp != nil {
+		if retryAfter, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && retryAfter > 0 {
+			delay := time.Duration(retryAfter) * time.Second
+			if delay > maxBackoff {
+				delay = maxBackoff
+			}
+
+			return delay
+		}
+	}
+
+	ceiling := policy.initialBackoff()
+	for i := 1; i < attempt && ceiling < maxBackoff; i++ {
+		ceiling *= 2
+	}
+	if ceiling > maxBackoff {
+		ceiling = maxBackoff
+	}
+
+	return jitter(ceiling)
+}
+
+// wait blocks for the backoff duration, returning early with the Context's error if it is done first.
+func (policy RetryPolicy) wait(ctx context.Context, attempt int, resp *http.Response) error {
+	timer := time.NewTimer(policy.backoff(attempt, resp))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+var (
+	jitterMu   sync.Mutex
+	jitterRand = rand.New(rand.NewSource(time.Now().UnixNano()))
+)
+
+// jitter returns a random duration between zero and ceiling, inclusive.
+func jitter(ceiling time.Duration) time.Duration {
+	jitterMu.Lock()
+	defer jitterMu.Unlock()
+
+	return time.Duration(jitterRand.Int63n(int64(ceiling) + 1))
+}
+
+// idempotentContextKey is the Context key used to mark a request as idempotent.
+type idempotentContextKey struct{}
+
+// BuildRequestIdempotent returns a RequestBuilder that marks a request as idempotent, permitting it to
+// be retried by a RetryPolicy regardless of its method.
+func BuildRequestIdempotent() RequestBuilder {
+	return func(r *http.Request) error {
+		*r = *r.WithContext(context.WithValue(r.Context(), idempotentContextKey{}, true))
+
+		return nil
+	}
+}
+
+// requestIdempotent returns true if the request may safely be performed multiple times.
+func requestIdempotent(r *http.Request) bool {
+	switch r.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	idempotent, _ := r.Context().Value(idempotentContextKey{}).(bool)
+
+	return idempotent
+}
+
+// requestReplayable returns true if the request has no Body, or its Body can be recreated.
+func requestReplayable(r *http.Request) bool {
+	return r.Body == nil || r.Body == http.NoBody || r.GetBody != nil
+}
+
+// requestForAttempt returns the http.Request to perform for the given attempt. The first attempt uses
+// the original request, and subsequent attempts use a clone with a fresh Body.
+func requestForAttempt(r *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 1 {
+		return r, nil
+	}
+
+	retryR := r.Clone(r.Context())
+	if r.GetBody != nil {
+		body, err := r.GetBody()
+		if err != nil {
+			return nil, err
+		}
+
+		retryR.Body = body
+	}
+
+	return retryR, nil
+}
+
+// isConnectError returns true if err indicates that a connection could not be established.
+func isConnectError(err error) bool {
+	var opErr *net.OpError
+
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// discardResponse reads and closes the Body of an http.Response that won't be handled, permitting
+// its connection to be reused.
+func discardResponse(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+	resp.Body.Close()
+}
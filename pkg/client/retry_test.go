@@ -0,0 +1,217 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_RetryPolicy(t *testing.T) {
+	type body struct {
+		Value string `values:"value"`
+	}
+
+	tests := []struct {
+		name          string
+		inputPolicy   RetryPolicy
+		inputMethod   string
+		inputBuilder  RequestBuilder
+		inputFailures int
+		wantAttempts  int
+		wantStatus    int
+	}{
+		{
+			name:          "no policy",
+			inputMethod:   http.MethodGet,
+			inputFailures: 1,
+			wantAttempts:  1,
+			wantStatus:    http.StatusServiceUnavailable,
+		},
+		{
+			name:          "idempotent method retried",
+			inputPolicy:   RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+			inputMethod:   http.MethodGet,
+			inputFailures: 2,
+			wantAttempts:  3,
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name:          "attempts exhausted",
+			inputPolicy:   RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+			inputMethod:   http.MethodGet,
+			inputFailures: 2,
+			wantAttempts:  2,
+			wantStatus:    http.StatusServiceUnavailable,
+		},
+		{
+			name:          "non-idempotent method not retried",
+			inputPolicy:   RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+			inputMethod:   http.MethodPost,
+			inputFailures: 1,
+			wantAttempts:  1,
+			wantStatus:    http.StatusServiceUnavailable,
+		},
+		{
+			name:          "non-idempotent method marked idempotent",
+			inputPolicy:   RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond},
+			inputMethod:   http.MethodPost,
+			inputBuilder:  BuildRequestIdempotent(),
+			inputFailures: 1,
+			wantAttempts:  2,
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name:          "non-idempotent method permitted",
+			inputPolicy:   RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, RetryNonIdempotent: true},
+			inputMethod:   http.MethodPost,
+			inputFailures: 1,
+			wantAttempts:  2,
+			wantStatus:    http.StatusOK,
+		},
+		{
+			name:          "non-retryable status code",
+			inputPolicy:   RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, RetryableStatusCodes: []int{http.StatusBadGateway}},
+			inputMethod:   http.MethodGet,
+			inputFailures: 1,
+			wantAttempts:  1,
+			wantStatus:    http.StatusServiceUnavailable,
+		},
+	}
+
+	for _, test := range tests {
+		var mu sync.Mutex
+		gotAttempts := 0
+		gotBodies := []string{}
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			data, _ := io.ReadAll(r.Body)
+			gotBodies = append(gotBodies, string(data))
+
+			gotAttempts++
+			if gotAttempts <= test.inputFailures {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+
+			w.WriteHeader(http.StatusOK)
+		}))
+
+		c := &Client{URL: server.URL, RetryPolicy: test.inputPolicy}
+
+		builders := []RequestBuilder{
+			BuildRequestMethod(test.inputMethod),
+			func(r *http.Request) error {
+				u, err := c.urlForPath("services", "retry")
+				r.URL = u
+
+				return err
+			},
+		}
+		if test.inputMethod == http.MethodPost {
+			builders = append(builders, BuildRequestBodyValues(body{Value: "replayed"}))
+		}
+		if test.inputBuilder != nil {
+			builders = append(builders, test.inputBuilder)
+		}
+
+		var gotStatus int
+		err := c.RequestAndHandleContext(
+			context.Background(),
+			ComposeRequestBuilder(builders...),
+			func(r *http.Response) error {
+				gotStatus = r.StatusCode
+
+				return nil
+			},
+		)
+		server.Close()
+
+		if err != nil {
+			t.Errorf("%s: RequestAndHandleContext() returned error: %s", test.name, err)
+		}
+
+		if gotAttempts != test.wantAttempts {
+			t.Errorf("%s: got %d attempts, want %d", test.name, gotAttempts, test.wantAttempts)
+		}
+
+		if gotStatus != test.wantStatus {
+			t.Errorf("%s: got status %d, want %d", test.name, gotStatus, test.wantStatus)
+		}
+
+		if test.inputMethod == http.MethodPost {
+			for _, gotBody := range gotBodies {
+				if gotBody != "value=replayed" {
+					t.Errorf("%s: got body %q, want %q", test.name, gotBody, "value=replayed")
+				}
+			}
+		}
+	}
+}
+
+func TestRetryPolicy_backoff(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputPolicy RetryPolicy
+		inputTry    int
+		inputHeader http.Header
+		wantMax     time.Duration
+	}{
+		{
+			name:     "defaults",
+			inputTry: 1,
+			wantMax:  defaultRetryInitialBackoff,
+		},
+		{
+			name:        "exponential",
+			inputPolicy: RetryPolicy{InitialBackoff: time.Second},
+			inputTry:    3,
+			wantMax:     time.Second * 4,
+		},
+		{
+			name:        "capped",
+			inputPolicy: RetryPolicy{InitialBackoff: time.Second, MaxBackoff: time.Second * 3},
+			inputTry:    5,
+			wantMax:     time.Second * 3,
+		},
+		{
+			name:        "Retry-After capped",
+			inputPolicy: RetryPolicy{MaxBackoff: time.Second * 3},
+			inputTry:    1,
+			inputHeader: http.Header{"Retry-After": []string{"60"}},
+			wantMax:     time.Second * 3,
+		},
+	}
+
+	for _, test := range tests {
+		var resp *http.Response
+		if test.inputHeader != nil {
+			resp = &http.Response{Header: test.inputHeader}
+		}
+
+		got := test.inputPolicy.backoff(test.inputTry, resp)
+		if got < 0 || got > test.wantMax {
+			t.Errorf("%s: backoff() got %s, want between 0 and %s", test.name, got, test.wantMax)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"encoding/json"
+	"net/url"
+)
+
+// SingletonID represents the ID of an object that is the only object of its service, such as
+// a global settings endpoint. Its service and entry paths are the same.
+//
+//	type Settings struct {
+//		ID      client.SingletonID `service:"server/settings/settings"`
+//		Content SettingsContent    `json:"content"`
+//	}
+type SingletonID struct {
+	Namespace Namespace
+}
+
+// GetServicePath implements custom GetServicePath encoding. It returns its Namespace's
+// service path.
+func (id SingletonID) GetServicePath(path string) (string, error) {
+	return id.Namespace.GetServicePath(path)
+}
+
+// GetEntryPath implements custom GetEntryPath encoding. It returns the same value as
+// GetServicePath.
+func (id SingletonID) GetEntryPath(path string) (string, error) {
+	return id.GetServicePath(path)
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling. The Namespace is parsed from the
+// ID URL.
+func (id *SingletonID) UnmarshalJSON(data []byte) error {
+	idString := ""
+	if err := json.Unmarshal(data, &idString); err != nil {
+		return wrapError(ErrorID, err, "client: unable to unmarshal %q as string", data)
+	}
+
+	ns, _, err := parseNamespace(idString)
+	if err != nil {
+		return err
+	}
+
+	id.Namespace = ns
+
+	return nil
+}
+
+// SetURLValues implements custom encoding into url.Values. A SingletonID has no values.
+func (id SingletonID) SetURLValues(key string, v *url.Values) error {
+	return nil
+}
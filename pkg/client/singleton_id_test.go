@@ -0,0 +1,67 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package client
+
+import (
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/service"
+)
+
+type testSingleton struct {
+	ID SingletonID `service:"test/settings"`
+}
+
+func TestSingletonID_paths(t *testing.T) {
+	tests := []struct {
+		name            string
+		input           testSingleton
+		wantServicePath string
+		wantEntryPath   string
+	}{
+		{
+			name:            "global",
+			input:           testSingleton{},
+			wantServicePath: "services/test/settings",
+			wantEntryPath:   "services/test/settings",
+		},
+		{
+			name:            "namespaced",
+			input:           testSingleton{ID: SingletonID{Namespace: Namespace{User: "nobody", App: "search"}}},
+			wantServicePath: "servicesNS/nobody/search/test/settings",
+			wantEntryPath:   "servicesNS/nobody/search/test/settings",
+		},
+	}
+
+	for _, test := range tests {
+		gotServicePath, err := service.ServicePath(test.input)
+		if err != nil {
+			t.Errorf("%s: ServicePath() returned error: %s", test.name, err)
+		}
+
+		if gotServicePath != test.wantServicePath {
+			t.Errorf("%s: ServicePath() got %s, want %s", test.name, gotServicePath, test.wantServicePath)
+		}
+
+		gotEntryPath, err := service.EntryPath(test.input)
+		if err != nil {
+			t.Errorf("%s: EntryPath() returned error: %s", test.name, err)
+		}
+
+		if gotEntryPath != test.wantEntryPath {
+			t.Errorf("%s: EntryPath() got %s, want %s", test.name, gotEntryPath, test.wantEntryPath)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cluster implements managing indexer clusters with the Splunk REST API. Requests are made
+// against the cluster manager.
+package cluster
+
+import (
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// Bundle describes a configuration bundle.
+type Bundle struct {
+	BundlePath string `json:"bundle_path"`
+	Checksum   string `json:"checksum"`
+	Timestamp  int    `json:"timestamp"`
+}
+
+// ApplyBundleStatus describes the status of the most recent bundle push.
+type ApplyBundleStatus struct {
+	ReloadBundleIssued bool   `json:"reload_bundle_issued"`
+	Status             string `json:"status"`
+}
+
+// ManagerInfoContent is the content for ManagerInfo.
+type ManagerInfoContent struct {
+	ActiveBundle       Bundle                      `json:"active_bundle"`
+	ApplyBundleStatus  ApplyBundleStatus           `json:"apply_bundle_status"`
+	IndexingReadyFlag  attributes.Explicit[bool]   `json:"indexing_ready_flag"`
+	InitializedFlag    attributes.Explicit[bool]   `json:"initialized_flag"`
+	Label              attributes.Explicit[string] `json:"label"`
+	LatestBundle       Bundle                      `json:"latest_bundle"`
+	MaintenanceMode    attributes.Explicit[bool]   `json:"maintenance_mode"`
+	Multisite          attributes.Explicit[bool]   `json:"multisite"`
+	RollingRestartFlag attributes.Explicit[bool]   `json:"rolling_restart_flag"`
+	ServiceReadyFlag   attributes.Explicit[bool]   `json:"service_ready_flag"`
+	StartTime          attributes.Explicit[int]    `json:"start_time"`
+}
+
+// ManagerInfo is the read-only status of the cluster manager. It can only be managed with Client.Read.
+type ManagerInfo struct {
+	ID      client.SingletonID `service:"cluster/master/info"`
+	Content ManagerInfoContent `json:"content" values:"-"`
+}
+
+// PeerContent is the content for a Peer.
+type PeerContent struct {
+	BucketCount           attributes.Explicit[int]    `json:"bucket_count"`
+	HostPortPair          attributes.Explicit[string] `json:"host_port_pair"`
+	IsSearchable          attributes.Explicit[bool]   `json:"is_searchable"`
+	Label                 attributes.Explicit[string] `json:"label"`
+	LastHeartbeat         attributes.Explicit[int]    `json:"last_heartbeat"`
+	RegisterSearchAddress attributes.Explicit[string] `json:"register_search_address"`
+	ReplicationPort       attributes.Explicit[int]    `json:"replication_port"`
+	Site                  attributes.Explicit[string] `json:"site"`
+	Status                attributes.Explicit[string] `json:"status"`
+}
+
+// Peer is a peer of the indexer cluster, as reported by the cluster manager. Its ID's Title is the
+// peer's GUID. Peers are read-only, and can be managed with Client.List and Client.Read.
+type Peer struct {
+	ID      client.ID   `service:"cluster/master/peers"`
+	Content PeerContent `json:"content" values:"-"`
+}
+
+// BucketPeer is the state of a Bucket's copy on a single peer.
+type BucketPeer struct {
+	SearchState string `json:"search_state"`
+	Status      string `json:"status"`
+}
+
+// BucketContent is the content for a Bucket.
+type BucketContent struct {
+	Frozen     attributes.Explicit[bool]   `json:"frozen"`
+	Index      attributes.Explicit[string] `json:"index"`
+	OriginSite attributes.Explicit[string] `json:"origin_site"`
+
+	// Peers are the Bucket's copies, keyed by peer GUID.
+	Peers map[string]BucketPeer `json:"peers"`
+}
+
+// Bucket is a bucket known to the cluster manager. Buckets are read-only, and can be managed with
+// Client.List and Client.Read.
+type Bucket struct {
+	ID      client.ID     `service:"cluster/master/buckets"`
+	Content BucketContent `json:"content" values:"-"`
+}
@@ -0,0 +1,285 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/authenticators"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+)
+
+func mustParseID(idURL string) client.ID {
+	id, err := client.ParseID(idURL)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+func TestCluster_UnmarshalJSON(t *testing.T) {
+	tests := checks.JSONUnmarshalTestCases{
+		{
+			Name:        "manager info",
+			InputString: `{"id":"https://localhost:8089/services/cluster/master/info/master","content":{"active_bundle":{"bundle_path":"/opt/splunk/var/run/splunk/cluster/remote-bundle/abc.bundle","checksum":"ABC","timestamp":1660000000},"apply_bundle_status":{"reload_bundle_issued":false,"status":"None"},"maintenance_mode":true,"rolling_restart_flag":false,"multisite":false}}`,
+			Want: ManagerInfo{
+				Content: ManagerInfoContent{
+					ActiveBundle: Bundle{
+						BundlePath: "/opt/splunk/var/run/splunk/cluster/remote-bundle/abc.bundle",
+						Checksum:   "ABC",
+						Timestamp:  1660000000,
+					},
+					ApplyBundleStatus:  ApplyBundleStatus{Status: "None"},
+					MaintenanceMode:    attributes.NewExplicit(true),
+					RollingRestartFlag: attributes.NewExplicit(false),
+					Multisite:          attributes.NewExplicit(false),
+				},
+			},
+		},
+		{
+			Name:        "peer",
+			InputString: `{"id":"https://localhost:8089/services/cluster/master/peers/0F1E2D3C-4B5A-6978-8796-A5B4C3D2E1F0","content":{"label":"idx1","status":"Up","is_searchable":true,"bucket_count":42,"site":"default"}}`,
+			Want: Peer{
+				ID: mustParseID("https://localhost:8089/services/cluster/master/peers/0F1E2D3C-4B5A-6978-8796-A5B4C3D2E1F0"),
+				Content: PeerContent{
+					Label:        attributes.NewExplicit("idx1"),
+					Status:       attributes.NewExplicit("Up"),
+					IsSearchable: attributes.NewExplicit(true),
+					BucketCount:  attributes.NewExplicit(42),
+					Site:         attributes.NewExplicit("default"),
+				},
+			},
+		},
+		{
+			Name:        "bucket",
+			InputString: `{"id":"https://localhost:8089/services/cluster/master/buckets/main~1~0F1E2D3C-4B5A-6978-8796-A5B4C3D2E1F0","content":{"index":"main","frozen":false,"peers":{"0F1E2D3C-4B5A-6978-8796-A5B4C3D2E1F0":{"search_state":"Searchable","status":"Complete"}}}}`,
+			Want: Bucket{
+				ID: mustParseID("https://localhost:8089/services/cluster/master/buckets/main~1~0F1E2D3C-4B5A-6978-8796-A5B4C3D2E1F0"),
+				Content: BucketContent{
+					Index:  attributes.NewExplicit("main"),
+					Frozen: attributes.NewExplicit(false),
+					Peers: map[string]BucketPeer{
+						"0F1E2D3C-4B5A-6978-8796-A5B4C3D2E1F0": {SearchState: "Searchable", Status: "Complete"},
+					},
+				},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
+
+func TestControl(t *testing.T) {
+	tests := []struct {
+		name         string
+		controlFunc  func(context.Context, *client.Client) error
+		requestCheck checks.CheckRequestFunc
+	}{
+		{
+			name: "validate bundle",
+			controlFunc: func(ctx context.Context, c *client.Client) error {
+				return ValidateBundle(ctx, c, ValidateBundleOptions{CheckRestart: attributes.NewExplicit(true)})
+			},
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodPost),
+				checks.CheckRequestURL("/services/cluster/master/control/default/validate_bundle?output_mode=json"),
+				checks.CheckRequestBodyValue("check-restart", "true"),
+			),
+		},
+		{
+			name: "apply bundle",
+			controlFunc: func(ctx context.Context, c *client.Client) error {
+				return ApplyBundle(ctx, c, ApplyBundleOptions{SkipValidation: attributes.NewExplicit(false)})
+			},
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodPost),
+				checks.CheckRequestURL("/services/cluster/master/control/default/apply?output_mode=json"),
+				checks.CheckRequestBodyValue("skip-validation", "false"),
+			),
+		},
+		{
+			name: "enable maintenance mode",
+			controlFunc: func(ctx context.Context, c *client.Client) error {
+				return SetMaintenanceMode(ctx, c, true)
+			},
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodPost),
+				checks.CheckRequestURL("/services/cluster/master/control/default/maintenance?output_mode=json"),
+				checks.CheckRequestBodyValue("mode", "true"),
+			),
+		},
+		{
+			name: "disable maintenance mode",
+			controlFunc: func(ctx context.Context, c *client.Client) error {
+				return SetMaintenanceMode(ctx, c, false)
+			},
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodPost),
+				checks.CheckRequestURL("/services/cluster/master/control/default/maintenance?output_mode=json"),
+				checks.CheckRequestBodyValue("mode", "false"),
+			),
+		},
+		{
+			name: "rolling restart",
+			controlFunc: func(ctx context.Context, c *client.Client) error {
+				return RollingRestart(ctx, c, RollingRestartOptions{Searchable: attributes.NewExplicit(true)})
+			},
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodPost),
+				checks.CheckRequestURL("/services/cluster/master/control/control/restart?output_mode=json"),
+				checks.CheckRequestBodyValue("searchable", "true"),
+			),
+		},
+	}
+
+	for _, test := range tests {
+		server := checks.NewCheckRequestServer(t, test.requestCheck, http.StatusOK, "")
+		c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+		err := test.controlFunc(context.Background(), c)
+		server.Close()
+
+		if err != nil {
+			t.Errorf("%s: returned error: %s", test.name, err)
+		}
+	}
+}
+
+func TestWaitForRollingRestart(t *testing.T) {
+	var mu sync.Mutex
+	restartingPolls := 3
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		restarting := restartingPolls > 0
+		restartingPolls--
+
+		fmt.Fprintf(w, `{"entry":[{"id":"https://localhost:8089/services/cluster/master/info/master","content":{"rolling_restart_flag":%v}}]}`, restarting)
+	}))
+	defer server.Close()
+
+	c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	info, err := WaitForRollingRestart(ctx, c, time.Millisecond, time.Second)
+	if err != nil {
+		t.Fatalf("WaitForRollingRestart() returned error: %s", err)
+	}
+
+	if info.Content.RollingRestartFlag.Value() {
+		t.Errorf("WaitForRollingRestart() returned while rolling restart in progress")
+	}
+
+	if restartingPolls != -1 {
+		t.Errorf("WaitForRollingRestart() returned after %d polls, want 4", 3-restartingPolls)
+	}
+}
+
+func TestWaitForRollingRestart_notYetStarted(t *testing.T) {
+	var mu sync.Mutex
+	// the rolling restart isn't reported as started by the first poll
+	flags := []bool{false, true, true, false}
+	polls := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		restarting := flags[len(flags)-1]
+		if polls < len(flags) {
+			restarting = flags[polls]
+		}
+		polls++
+
+		fmt.Fprintf(w, `{"entry":[{"id":"https://localhost:8089/services/cluster/master/info/master","content":{"rolling_restart_flag":%v}}]}`, restarting)
+	}))
+	defer server.Close()
+
+	c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+
+	if _, err := WaitForRollingRestart(ctx, c, time.Millisecond, time.Second); err != nil {
+		t.Fatalf("WaitForRollingRestart() returned error: %s", err)
+	}
+
+	if polls != len(flags) {
+		t.Errorf("WaitForRollingRestart() returned after %d polls, want %d", polls, len(flags))
+	}
+}
+
+func TestWaitForRollingRestart_notReported(t *testing.T) {
+	tests := []struct {
+		name              string
+		inputStartTimeout time.Duration
+		wantMinPolls      int
+		wantMaxPolls      int
+	}{
+		{
+			// the rolling restart started and completed before the first poll
+			name:              "start timeout",
+			inputStartTimeout: time.Millisecond * 20,
+			wantMinPolls:      2,
+			wantMaxPolls:      1000,
+		},
+		{
+			name:         "no start timeout",
+			wantMinPolls: 1,
+			wantMaxPolls: 1,
+		},
+	}
+
+	for _, test := range tests {
+		var mu sync.Mutex
+		polls := 0
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			polls++
+
+			fmt.Fprint(w, `{"entry":[{"id":"https://localhost:8089/services/cluster/master/info/master","content":{"rolling_restart_flag":false}}]}`)
+		}))
+
+		c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+		// no deadline, so WaitForRollingRestart must return on its own
+		_, err := WaitForRollingRestart(context.Background(), c, time.Millisecond, test.inputStartTimeout)
+		server.Close()
+
+		if err != nil {
+			t.Errorf("%s: WaitForRollingRestart() returned error: %s", test.name, err)
+		}
+
+		if polls < test.wantMinPolls || polls > test.wantMaxPolls {
+			t.Errorf("%s: WaitForRollingRestart() returned after %d polls, want %d to %d", test.name, polls, test.wantMinPolls, test.wantMaxPolls)
+		}
+	}
+}
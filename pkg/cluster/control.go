@@ -0,0 +1,138 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// controlDefault is used to build paths to the cluster manager's default control endpoints.
+type controlDefault struct {
+	_ client.Namespace `service:"cluster/master/control/default"`
+}
+
+// controlControl is used to build paths to the cluster manager's control endpoints, such as restart.
+type controlControl struct {
+	_ client.Namespace `service:"cluster/master/control/control"`
+}
+
+// ValidateBundleOptions define how the configuration bundle is validated.
+type ValidateBundleOptions struct {
+	// CheckRestart causes validation to also determine if applying the bundle requires a restart
+	// of the peers. The result is reported in ManagerInfo's ApplyBundleStatus once validation completes.
+	CheckRestart attributes.Explicit[bool] `values:"check-restart,omitzero"`
+}
+
+// ApplyBundleOptions define how the configuration bundle is applied.
+type ApplyBundleOptions struct {
+	// SkipValidation skips validation of the bundle before it is applied.
+	SkipValidation attributes.Explicit[bool] `values:"skip-validation,omitzero"`
+
+	// IgnoreIdenticalBundle skips applying the bundle if it is identical to the active bundle.
+	IgnoreIdenticalBundle attributes.Explicit[bool] `values:"ignore_identical_bundle,omitzero"`
+}
+
+// RollingRestartOptions define how a rolling restart of the peers is performed.
+type RollingRestartOptions struct {
+	// Searchable restarts peers such that the cluster remains searchable.
+	Searchable attributes.Explicit[bool] `values:"searchable,omitzero"`
+
+	// Force performs a searchable rolling restart even if the cluster isn't healthy.
+	Force attributes.Explicit[bool] `values:"force,omitzero"`
+}
+
+// maintenanceRequest is the request sent to set maintenance mode.
+type maintenanceRequest struct {
+	Mode bool `values:"mode"`
+}
+
+// control performs a control action against the cluster manager.
+func control(ctx context.Context, c *client.Client, service interface{}, action string, body interface{}) error {
+	return c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestServiceActionURL(c, service, action),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestBodyValues(body),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+		),
+	)
+}
+
+// ValidateBundle validates the configuration bundle on the cluster manager without applying it.
+func ValidateBundle(ctx context.Context, c *client.Client, options ValidateBundleOptions) error {
+	return control(ctx, c, controlDefault{}, "validate_bundle", options)
+}
+
+// ApplyBundle pushes the configuration bundle from the cluster manager to the peers. The peers may
+// be restarted if the bundle requires it. Progress is reported in ManagerInfo's ApplyBundleStatus.
+func ApplyBundle(ctx context.Context, c *client.Client, options ApplyBundleOptions) error {
+	return control(ctx, c, controlDefault{}, "apply", options)
+}
+
+// SetMaintenanceMode enables or disables maintenance mode, which halts most bucket fixup activity.
+func SetMaintenanceMode(ctx context.Context, c *client.Client, enabled bool) error {
+	return control(ctx, c, controlDefault{}, "maintenance", maintenanceRequest{Mode: enabled})
+}
+
+// RollingRestart begins a rolling restart of the peers. It returns once the cluster manager has
+// accepted the request. Use WaitForRollingRestart with a non-zero startTimeout to wait for it to
+// complete.
+func RollingRestart(ctx context.Context, c *client.Client, options RollingRestartOptions) error {
+	return control(ctx, c, controlControl{}, "restart", options)
+}
+
+// WaitForRollingRestart reads ManagerInfo every interval until it reports that no rolling restart is
+// in progress, returning the final ManagerInfo.
+//
+// Call it with a non-zero startTimeout right after RollingRestart, as the cluster manager may not yet
+// report the rolling restart as in progress. It then waits up to startTimeout for the rolling restart
+// to be reported before accepting that none is in progress, as a rolling restart that isn't reported
+// within startTimeout is assumed to have started and completed between polls. With a zero
+// startTimeout it returns as soon as no rolling restart is in progress.
+func WaitForRollingRestart(ctx context.Context, c *client.Client, interval time.Duration, startTimeout time.Duration) (ManagerInfo, error) {
+	seenRestarting := false
+	startDeadline := time.Now().Add(startTimeout)
+
+	for {
+		info := ManagerInfo{}
+		if err := c.ReadContext(ctx, &info); err != nil {
+			return ManagerInfo{}, err
+		}
+
+		if info.Content.RollingRestartFlag.Value() {
+			seenRestarting = true
+		} else if seenRestarting || !time.Now().Before(startDeadline) {
+			return info, nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ManagerInfo{}, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
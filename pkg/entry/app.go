@@ -0,0 +1,149 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// AppContent is the content for an App.
+type AppContent struct {
+	Author          attributes.Explicit[string] `json:"author"            values:"author,omitzero"`
+	CheckForUpdates attributes.Explicit[bool]   `json:"check_for_updates" values:"check_for_updates,omitzero" selective:"update"`
+	Configured      attributes.Explicit[bool]   `json:"configured"        values:"configured,omitzero"`
+	Description     attributes.Explicit[string] `json:"description"       values:"description,omitzero"`
+	Disabled        attributes.Explicit[bool]   `json:"disabled"          values:"disabled,omitzero"          selective:"read"`
+	Label           attributes.Explicit[string] `json:"label"             values:"label,omitzero"`
+	Version         attributes.Explicit[string] `json:"version"           values:"version,omitzero"`
+	Visible         attributes.Explicit[bool]   `json:"visible"           values:"visible,omitzero"`
+
+	// Read-only fields are populated by results returned by the Splunk API, but
+	// are not settable by Create or Update operations.
+	Core                       attributes.Explicit[bool] `json:"core"                          values:"-"`
+	StateChangeRequiresRestart attributes.Explicit[bool] `json:"state_change_requires_restart" values:"-"`
+}
+
+// App is a Splunk app. Creating an App creates a new, empty app from its Content. Use InstallApp to
+// install an app from a package. Apps can be enabled and disabled with Client.Enable and Client.Disable,
+// and reloaded from disk with Client.Reload.
+type App struct {
+	ID      client.ID  `selective:"create" service:"apps/local"`
+	Content AppContent `json:"content" values:",anonymize"`
+}
+
+// AppInstallOptions defines the parameters used to install an app from a package.
+type AppInstallOptions struct {
+	// Path is the path, on the Splunk instance, of the app package to install. This may be a tarball
+	// that has been placed on the instance, or a package that has been uploaded to it.
+	Path string `values:"name"`
+
+	// Update permits an existing app to be overwritten by the package.
+	Update attributes.Explicit[bool] `values:"update,omitzero"`
+
+	// ExplicitAppName sets the name of the installed app, rather than using the name from the package.
+	ExplicitAppName attributes.Explicit[string] `values:"explicit_appname,omitzero"`
+}
+
+// appInstallRequest is the request sent to install an app from a package.
+type appInstallRequest struct {
+	AppInstallOptions `values:",anonymize"`
+
+	Filename bool `values:"filename"`
+}
+
+// InstallApp installs an app from a package in the given Namespace, returning the installed App.
+func InstallApp(ctx context.Context, c *client.Client, ns client.Namespace, options AppInstallOptions) (App, error) {
+	app := App{ID: client.ID{Namespace: ns}}
+
+	err := c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestServiceURL(c, app),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestBodyValues(appInstallRequest{AppInstallOptions: options, Filename: true}),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseRequireCode(http.StatusCreated, client.HandleResponseJSONMessagesError()),
+			client.HandleResponseEntry(&app),
+		),
+	)
+
+	return app, err
+}
+
+// AppPackage describes an app package created by App.Package.
+type AppPackage struct {
+	Name string `json:"name"`
+	Path string `json:"path"`
+	URL  string `json:"url"`
+}
+
+// Package creates a package of the App on the Splunk instance, returning its location.
+func (app App) Package(ctx context.Context, c *client.Client) (AppPackage, error) {
+	var packaged struct {
+		ID      client.ID
+		Content AppPackage `json:"content"`
+	}
+
+	err := c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodGet),
+			client.BuildRequestEntryActionURL(c, app, "package"),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseCode(http.StatusNotFound, client.HandleResponseJSONMessagesCustomError(client.ErrorNotFound)),
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+			client.HandleResponseEntry(&packaged),
+		),
+	)
+
+	return packaged.Content, err
+}
+
+// Setup returns the App's setup configuration, or an empty string if the App has no setup.
+func (app App) Setup(ctx context.Context, c *client.Client) (string, error) {
+	var setup struct {
+		ID      client.ID
+		Content struct {
+			Setup string `json:"eai:setup"`
+		} `json:"content"`
+	}
+
+	err := c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodGet),
+			client.BuildRequestEntryActionURL(c, app, "setup"),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseCode(http.StatusNotFound, client.HandleResponseJSONMessagesCustomError(client.ErrorNotFound)),
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+			client.HandleResponseEntry(&setup),
+		),
+	)
+
+	return setup.Content.Setup, err
+}
@@ -0,0 +1,158 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/authenticators"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+)
+
+func TestApp_values(t *testing.T) {
+	app := App{
+		ID: client.ID{Title: "my_app"},
+		Content: AppContent{
+			Author:          attributes.NewExplicit("admin"),
+			CheckForUpdates: attributes.NewExplicit(false),
+			Disabled:        attributes.NewExplicit(true),
+			Version:         attributes.NewExplicit("1.0.0"),
+			Visible:         attributes.NewExplicit(true),
+		},
+	}
+
+	tests := checks.QueryValuesTestCases{
+		{
+			Name:  "create",
+			Input: checks.MustSelective(t, app, "create"),
+			Want: url.Values{
+				"name":    []string{"my_app"},
+				"author":  []string{"admin"},
+				"version": []string{"1.0.0"},
+				"visible": []string{"true"},
+			},
+		},
+		{
+			Name:  "update",
+			Input: checks.MustSelective(t, app, "update"),
+			Want: url.Values{
+				"author":            []string{"admin"},
+				"check_for_updates": []string{"false"},
+				"version":           []string{"1.0.0"},
+				"visible":           []string{"true"},
+			},
+		},
+		{
+			Name: "install",
+			Input: appInstallRequest{
+				AppInstallOptions: AppInstallOptions{
+					Path:   "/tmp/my_app.tgz",
+					Update: attributes.NewExplicit(true),
+				},
+				Filename: true,
+			},
+			Want: url.Values{
+				"name":     []string{"/tmp/my_app.tgz"},
+				"update":   []string{"true"},
+				"filename": []string{"true"},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
+
+func TestApp_actions(t *testing.T) {
+	tests := []struct {
+		name         string
+		responseCode int
+		responseBody string
+		appFunc      func(context.Context, *client.Client) (interface{}, error)
+		want         interface{}
+		requestCheck checks.CheckRequestFunc
+	}{
+		{
+			name:         "install",
+			responseCode: http.StatusCreated,
+			responseBody: `{"entry":[{"id":"https://localhost:8089/servicesNS/nobody/system/apps/local/my_app","content":{"version":"1.0.0"}}]}`,
+			appFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				return InstallApp(ctx, c, client.Namespace{}, AppInstallOptions{Path: "/tmp/my_app.tgz", Update: attributes.NewExplicit(true)})
+			},
+			want: App{
+				ID:      mustParseID("https://localhost:8089/servicesNS/nobody/system/apps/local/my_app"),
+				Content: AppContent{Version: attributes.NewExplicit("1.0.0")},
+			},
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodPost),
+				checks.CheckRequestURL("/services/apps/local?output_mode=json"),
+				checks.CheckRequestBodyValue("name", "/tmp/my_app.tgz"),
+				checks.CheckRequestBodyValue("update", "true"),
+				checks.CheckRequestBodyValue("filename", "true"),
+			),
+		},
+		{
+			name:         "package",
+			responseCode: http.StatusOK,
+			responseBody: `{"entry":[{"id":"https://localhost:8089/servicesNS/nobody/system/apps/local/my_app/package","content":{"name":"my_app","path":"/opt/splunk/share/splunk/app_packages/my_app.spl","url":"https://localhost:8000/static/app-packages/my_app.spl"}}]}`,
+			appFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				return App{ID: client.ID{Title: "my_app"}}.Package(ctx, c)
+			},
+			want: AppPackage{
+				Name: "my_app",
+				Path: "/opt/splunk/share/splunk/app_packages/my_app.spl",
+				URL:  "https://localhost:8000/static/app-packages/my_app.spl",
+			},
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodGet),
+				checks.CheckRequestURL("/services/apps/local/my_app/package?output_mode=json"),
+			),
+		},
+		{
+			name:         "setup",
+			responseCode: http.StatusOK,
+			responseBody: `{"entry":[{"id":"https://localhost:8089/servicesNS/nobody/system/apps/local/my_app/setup","content":{"eai:setup":"<setup/>"}}]}`,
+			appFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				return App{ID: client.ID{Title: "my_app"}}.Setup(ctx, c)
+			},
+			want: "<setup/>",
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodGet),
+				checks.CheckRequestURL("/services/apps/local/my_app/setup?output_mode=json"),
+			),
+		},
+	}
+
+	for _, test := range tests {
+		server := checks.NewCheckRequestServer(t, test.requestCheck, test.responseCode, test.responseBody)
+		c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+		got, err := test.appFunc(context.Background(), c)
+		server.Close()
+
+		if err != nil {
+			t.Errorf("%s: returned error: %s", test.name, err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got\n%#v, want\n%#v", test.name, got, test.want)
+		}
+	}
+}
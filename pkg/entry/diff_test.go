@@ -0,0 +1,76 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+func TestDiffEntries_entries(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputCurrent interface{}
+		inputDesired interface{}
+		want         []client.Change
+	}{
+		{
+			name: "stanza values",
+			inputCurrent: Stanza{Content: StanzaContent{
+				Disabled: attributes.NewExplicit(false),
+				Values:   map[string]string{"key1": "current", "key2": "unmanaged"},
+			}},
+			inputDesired: Stanza{Content: StanzaContent{
+				Values: map[string]string{"key1": "desired", "key3": "new"},
+			}},
+			want: []client.Change{
+				{Key: "key1", Current: []string{"current"}, Desired: []string{"desired"}},
+				{Key: "key3", Desired: []string{"new"}},
+			},
+		},
+		{
+			name: "savedsearch actions",
+			inputCurrent: SavedSearch{Content: SavedSearchContent{
+				Actions: attributes.NamedParametersCollection{
+					{Name: "email", Status: attributes.NewExplicit("1"), Parameters: attributes.Parameters{"to": "current@example.com"}},
+					{Name: "script", Status: attributes.NewExplicit("0")},
+				},
+			}},
+			inputDesired: SavedSearch{Content: SavedSearchContent{
+				Actions: attributes.NamedParametersCollection{
+					{Name: "email", Status: attributes.NewExplicit("1"), Parameters: attributes.Parameters{"to": "desired@example.com"}},
+				},
+			}},
+			want: []client.Change{
+				{Key: "action.email.to", Current: []string{"current@example.com"}, Desired: []string{"desired@example.com"}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got, err := client.DiffEntries(test.inputCurrent, test.inputDesired)
+		if err != nil {
+			t.Errorf("%s: DiffEntries() returned error: %s", test.name, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: DiffEntries() got\n%#v, want\n%#v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// EventTypeContent is the content for an EventType.
+type EventTypeContent struct {
+	Color       attributes.Explicit[string] `json:"color"       values:"color,omitzero"`
+	Description attributes.Explicit[string] `json:"description" values:"description,omitzero"`
+	Disabled    attributes.Explicit[bool]   `json:"disabled"    values:"disabled,omitzero"    selective:"read"`
+	Priority    attributes.Explicit[int]    `json:"priority"    values:"priority,omitzero"`
+	Search      attributes.Explicit[string] `json:"search"      values:"search,omitzero"`
+
+	// Read-only fields are populated by results returned by the Splunk API, but
+	// are not settable by Create or Update operations.
+	Tags []string `json:"tags" values:"-"`
+}
+
+// EventType is a Splunk eventtype.
+type EventType struct {
+	ID      client.ID        `selective:"create" service:"saved/eventtypes"`
+	Content EventTypeContent `json:"content" values:",anonymize"`
+}
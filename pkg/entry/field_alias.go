@@ -0,0 +1,62 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"encoding/json"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// FieldAliasContent is the content for a FieldAlias.
+type FieldAliasContent struct {
+	// Aliases maps original field names to their aliases.
+	Aliases attributes.Parameters `json:"-" values:"alias,omitzero"`
+
+	// Stanza is the props.conf stanza (sourcetype, source or host) the FieldAlias applies to.
+	Stanza attributes.Explicit[string] `json:"stanza" values:"stanza,omitzero" selective:"create"`
+
+	// Read-only fields are populated by results returned by the Splunk API, but
+	// are not settable by Create or Update operations.
+	Attribute attributes.Explicit[string] `json:"attribute" values:"-"`
+	Value     attributes.Explicit[string] `json:"value"     values:"-"`
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling.
+func (content *FieldAliasContent) UnmarshalJSON(data []byte) error {
+	type contentAlias FieldAliasContent
+	var newAliasedContent contentAlias
+
+	if err := json.Unmarshal(data, &newAliasedContent); err != nil {
+		return err
+	}
+
+	aliases, err := prefixedParameters(data, "alias")
+	if err != nil {
+		return err
+	}
+	newAliasedContent.Aliases = aliases
+
+	*content = FieldAliasContent(newAliasedContent)
+
+	return nil
+}
+
+// FieldAlias is a field alias, defined as a FIELDALIAS class in props.conf.
+type FieldAlias struct {
+	ID      client.ID         `selective:"create" service:"data/props/fieldaliases"`
+	Content FieldAliasContent `json:"content" values:",anonymize"`
+}
@@ -0,0 +1,62 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// HECTokenContent is the content for a HECToken.
+type HECTokenContent struct {
+	Description attributes.Explicit[string] `json:"description" values:"description,omitzero"`
+	Disabled    attributes.Explicit[bool]   `json:"disabled"    values:"disabled,omitzero"    selective:"read"`
+	Host        attributes.Explicit[string] `json:"host"        values:"host,omitzero"`
+	Index       attributes.Explicit[string] `json:"index"       values:"index,omitzero"`
+	Indexes     []string                    `json:"indexes"     values:"indexes,omitzero"`
+	Source      attributes.Explicit[string] `json:"source"      values:"source,omitzero"`
+	SourceType  attributes.Explicit[string] `json:"sourcetype"  values:"sourcetype,omitzero"`
+	Token       attributes.Explicit[string] `json:"token"       values:"token,omitzero"       selective:"create"`
+	UseACK      attributes.Explicit[bool]   `json:"useACK"      values:"useACK,omitzero"`
+}
+
+// HECToken is a Splunk HTTP Event Collector token. Its Title is returned by Splunk with an
+// "http://" prefix. HECTokens can be enabled and disabled with Client.Enable and Client.Disable.
+type HECToken struct {
+	ID      client.ID       `selective:"create" service:"data/inputs/http"`
+	Content HECTokenContent `json:"content" values:",anonymize"`
+}
+
+// HECGlobalSettingsContent is the content for HECGlobalSettings.
+type HECGlobalSettingsContent struct {
+	DedicatedIoThreads  attributes.Explicit[int]    `json:"dedicatedIoThreads"  values:"dedicatedIoThreads,omitzero"`
+	Disabled            attributes.Explicit[bool]   `json:"disabled"            values:"disabled,omitzero"`
+	EnableSSL           attributes.Explicit[bool]   `json:"enableSSL"           values:"enableSSL,omitzero"`
+	Host                attributes.Explicit[string] `json:"host"                values:"host,omitzero"`
+	Index               attributes.Explicit[string] `json:"index"               values:"index,omitzero"`
+	MaxSockets          attributes.Explicit[int]    `json:"maxSockets"          values:"maxSockets,omitzero"`
+	MaxThreads          attributes.Explicit[int]    `json:"maxThreads"          values:"maxThreads,omitzero"`
+	Port                attributes.Explicit[int]    `json:"port"                values:"port,omitzero"`
+	Source              attributes.Explicit[string] `json:"source"              values:"source,omitzero"`
+	SourceType          attributes.Explicit[string] `json:"sourcetype"          values:"sourcetype,omitzero"`
+	UseDeploymentServer attributes.Explicit[bool]   `json:"useDeploymentServer" values:"useDeploymentServer,omitzero"`
+}
+
+// HECGlobalSettings are the global settings of the HTTP Event Collector. They can only be
+// managed with Client.Read and Client.Update.
+type HECGlobalSettings struct {
+	ID      client.SingletonID       `service:"data/inputs/http/http"`
+	Content HECGlobalSettingsContent `json:"content" values:",anonymize"`
+}
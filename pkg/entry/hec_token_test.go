@@ -0,0 +1,106 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+)
+
+func TestHECToken_UnmarshalJSON(t *testing.T) {
+	tests := checks.JSONUnmarshalTestCases{
+		{
+			Name:        "token",
+			InputString: `{"id":"https://localhost:8089/servicesNS/nobody/splunk_httpinput/data/inputs/http/http%3A%2F%2Ftest","content":{"token":"00000000-0000-0000-0000-000000000000","index":"main","indexes":["main","summary"],"useACK":true,"disabled":false}}`,
+			Want: HECToken{
+				ID: mustParseID("https://localhost:8089/servicesNS/nobody/splunk_httpinput/data/inputs/http/http%3A%2F%2Ftest"),
+				Content: HECTokenContent{
+					Token:    attributes.NewExplicit("00000000-0000-0000-0000-000000000000"),
+					Index:    attributes.NewExplicit("main"),
+					Indexes:  []string{"main", "summary"},
+					UseACK:   attributes.NewExplicit(true),
+					Disabled: attributes.NewExplicit(false),
+				},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
+
+func TestHECToken_values(t *testing.T) {
+	token := HECToken{
+		ID: client.ID{Title: "test"},
+		Content: HECTokenContent{
+			Token:    attributes.NewExplicit("00000000-0000-0000-0000-000000000000"),
+			Indexes:  []string{"main", "summary"},
+			Disabled: attributes.NewExplicit(true),
+		},
+	}
+
+	tests := checks.QueryValuesTestCases{
+		{
+			Name:  "create",
+			Input: checks.MustSelective(t, token, "create"),
+			Want: url.Values{
+				"name":    []string{"test"},
+				"token":   []string{"00000000-0000-0000-0000-000000000000"},
+				"indexes": []string{"main", "summary"},
+			},
+		},
+		{
+			Name:  "update",
+			Input: checks.MustSelective(t, token, "update"),
+			Want: url.Values{
+				"indexes": []string{"main", "summary"},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
+
+func TestHECGlobalSettings_UnmarshalJSON(t *testing.T) {
+	tests := checks.JSONUnmarshalTestCases{
+		{
+			Name:        "settings",
+			InputString: `{"id":"https://localhost:8089/servicesNS/nobody/splunk_httpinput/data/inputs/http/http","content":{"disabled":false,"enableSSL":true,"port":8088}}`,
+			Want: HECGlobalSettings{
+				ID: client.SingletonID{Namespace: client.Namespace{User: "nobody", App: "splunk_httpinput"}},
+				Content: HECGlobalSettingsContent{
+					Disabled:  attributes.NewExplicit(false),
+					EnableSSL: attributes.NewExplicit(true),
+					Port:      attributes.NewExplicit(8088),
+				},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
+
+// mustParseID returns the client.ID parsed from idURL, panicking if it can't be parsed.
+func mustParseID(idURL string) client.ID {
+	id, err := client.ParseID(idURL)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
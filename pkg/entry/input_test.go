@@ -0,0 +1,138 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/authenticators"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+)
+
+func TestInputs_values(t *testing.T) {
+	udpInput := UDPInput{
+		ID: client.ID{Title: "514"},
+		Content: UDPInputContent{
+			RestrictToHost: attributes.NewExplicit("localhost"),
+			SourceType:     attributes.NewExplicit("syslog"),
+			Disabled:       attributes.NewExplicit(true),
+		},
+	}
+
+	monitorInput := MonitorInput{
+		ID: client.ID{Title: "/var/log"},
+		Content: MonitorInputContent{
+			CrcSalt:         attributes.NewExplicit("<SOURCE>"),
+			IgnoreOlderThan: attributes.NewExplicit("7d"),
+		},
+	}
+
+	tests := checks.QueryValuesTestCases{
+		{
+			Name:  "udp create",
+			Input: checks.MustSelective(t, udpInput, "create"),
+			Want: url.Values{
+				"name":           []string{"514"},
+				"restrictToHost": []string{"localhost"},
+				"sourcetype":     []string{"syslog"},
+			},
+		},
+		{
+			Name:  "udp update",
+			Input: checks.MustSelective(t, udpInput, "update"),
+			Want: url.Values{
+				"sourcetype": []string{"syslog"},
+			},
+		},
+		{
+			Name:  "monitor create",
+			Input: checks.MustSelective(t, monitorInput, "create"),
+			Want: url.Values{
+				"name":              []string{"/var/log"},
+				"crc-salt":          []string{"<SOURCE>"},
+				"ignore-older-than": []string{"7d"},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
+
+func TestInputs_actions(t *testing.T) {
+	ns := client.Namespace{User: "nobody", App: "search"}
+
+	tests := []struct {
+		name         string
+		inputFunc    func(context.Context, *client.Client) (interface{}, error)
+		want         interface{}
+		requestCheck checks.CheckRequestFunc
+	}{
+		{
+			name: "monitor members",
+			inputFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				return MonitorInput{ID: client.ID{Namespace: ns, Title: "/var/log"}}.Members(ctx, c)
+			},
+			want: []string{"/var/log/messages"},
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodGet),
+				checks.CheckRequestURL("/servicesNS/nobody/search/data/inputs/monitor/%2Fvar%2Flog/members?count=1000&offset=0&output_mode=json"),
+			),
+		},
+		{
+			name: "tcp raw connects",
+			inputFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				return TCPRawInput{ID: client.ID{Namespace: ns, Title: "9999"}}.Connections(ctx, c)
+			},
+			want: []string{"/var/log/messages"},
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodGet),
+				checks.CheckRequestURL("/servicesNS/nobody/search/data/inputs/tcp/raw/9999/connects?count=1000&offset=0&output_mode=json"),
+			),
+		},
+		{
+			name: "script restart",
+			inputFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				return nil, ScriptInput{ID: client.ID{Namespace: ns, Title: "/opt/splunk/bin/scripts/test.sh"}}.Restart(ctx, c)
+			},
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodPost),
+				checks.CheckRequestURL("/servicesNS/nobody/search/data/inputs/script/restart?output_mode=json"),
+				checks.CheckRequestBodyValue("script", "/opt/splunk/bin/scripts/test.sh"),
+			),
+		},
+	}
+
+	for _, test := range tests {
+		server := checks.NewCheckRequestServer(t, test.requestCheck, http.StatusOK, `{"entry":[{"id":"https://localhost:8089/servicesNS/nobody/search/data/inputs/member/%2Fvar%2Flog%2Fmessages"}]}`)
+		c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+		got, err := test.inputFunc(context.Background(), c)
+		server.Close()
+
+		if err != nil {
+			t.Errorf("%s: returned error: %s", test.name, err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got\n%#v, want\n%#v", test.name, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/authenticators"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+)
+
+func TestKnowledgeObjects_UnmarshalJSON(t *testing.T) {
+	tests := checks.JSONUnmarshalTestCases{
+		{
+			Name:        "macro",
+			InputString: `{"id":"https://localhost:8089/servicesNS/nobody/search/admin/macros/my_macro%282%29","content":{"args":"a,b","definition":"$a$=$b$","iseval":false}}`,
+			Want: Macro{
+				ID: mustParseID("https://localhost:8089/servicesNS/nobody/search/admin/macros/my_macro%282%29"),
+				Content: MacroContent{
+					Args:       attributes.NewExplicit("a,b"),
+					Definition: attributes.NewExplicit("$a$=$b$"),
+					IsEval:     attributes.NewExplicit(false),
+				},
+			},
+		},
+		{
+			Name:        "eventtype",
+			InputString: `{"id":"https://localhost:8089/servicesNS/nobody/search/saved/eventtypes/failed_login","content":{"search":"action=failure","priority":1,"tags":["authentication","failure"]}}`,
+			Want: EventType{
+				ID: mustParseID("https://localhost:8089/servicesNS/nobody/search/saved/eventtypes/failed_login"),
+				Content: EventTypeContent{
+					Search:   attributes.NewExplicit("action=failure"),
+					Priority: attributes.NewExplicit(1),
+					Tags:     []string{"authentication", "failure"},
+				},
+			},
+		},
+		{
+			Name:        "field alias",
+			InputString: `{"id":"https://localhost:8089/servicesNS/nobody/search/data/props/fieldaliases/syslog%20%3A%20FIELDALIAS-src","content":{"alias.src_ip":"src","attribute":"FIELDALIAS-src","stanza":"syslog","value":"src_ip AS src","eai:acl":{"app":"search"}}}`,
+			Want: FieldAlias{
+				ID: mustParseID("https://localhost:8089/servicesNS/nobody/search/data/props/fieldaliases/syslog%20%3A%20FIELDALIAS-src"),
+				Content: FieldAliasContent{
+					Aliases:   attributes.Parameters{"src_ip": "src"},
+					Stanza:    attributes.NewExplicit("syslog"),
+					Attribute: attributes.NewExplicit("FIELDALIAS-src"),
+					Value:     attributes.NewExplicit("src_ip AS src"),
+				},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
+
+func TestKnowledgeObjects_values(t *testing.T) {
+	macro := Macro{
+		ID: client.ID{Title: "my_macro(2)"},
+		Content: MacroContent{
+			Args:       attributes.NewExplicit("a,b"),
+			Definition: attributes.NewExplicit("$a$=$b$"),
+			Disabled:   attributes.NewExplicit(true),
+		},
+	}
+
+	eventType := EventType{
+		ID: client.ID{Title: "failed_login"},
+		Content: EventTypeContent{
+			Search: attributes.NewExplicit("action=failure"),
+			Tags:   []string{"authentication"},
+		},
+	}
+
+	fieldAlias := FieldAlias{
+		ID: client.ID{Title: "src"},
+		Content: FieldAliasContent{
+			Aliases: attributes.Parameters{"src_ip": "src"},
+			Stanza:  attributes.NewExplicit("syslog"),
+			Value:   attributes.NewExplicit("src_ip AS src"),
+		},
+	}
+
+	tests := checks.QueryValuesTestCases{
+		{
+			Name:  "macro create",
+			Input: checks.MustSelective(t, macro, "create"),
+			Want: url.Values{
+				"name":       []string{"my_macro(2)"},
+				"args":       []string{"a,b"},
+				"definition": []string{"$a$=$b$"},
+			},
+		},
+		{
+			Name:  "eventtype create",
+			Input: checks.MustSelective(t, eventType, "create"),
+			Want: url.Values{
+				"name":   []string{"failed_login"},
+				"search": []string{"action=failure"},
+			},
+		},
+		{
+			Name:  "field alias create",
+			Input: checks.MustSelective(t, fieldAlias, "create"),
+			Want: url.Values{
+				"name":         []string{"src"},
+				"stanza":       []string{"syslog"},
+				"alias.src_ip": []string{"src"},
+			},
+		},
+		{
+			Name:  "field alias update",
+			Input: checks.MustSelective(t, fieldAlias, "update"),
+			Want: url.Values{
+				"alias.src_ip": []string{"src"},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
+
+func TestTag(t *testing.T) {
+	tag := Tag{ID: client.ID{Namespace: client.Namespace{User: "nobody", App: "search"}, Title: "web"}}
+
+	tests := []struct {
+		name         string
+		tagFunc      func(context.Context, *client.Client) (interface{}, error)
+		responseCode int
+		responseBody string
+		want         interface{}
+		requestCheck checks.CheckRequestFunc
+	}{
+		{
+			name: "field values",
+			tagFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				return tag.FieldValues(ctx, c)
+			},
+			responseCode: http.StatusOK,
+			responseBody: `{"entry":[{"id":"https://localhost:8089/servicesNS/nobody/search/search/tags/web/sourcetype%3A%3Aaccess_combined"}]}`,
+			want:         []string{"sourcetype::access_combined"},
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodGet),
+				checks.CheckRequestURL("/servicesNS/nobody/search/search/tags/web?count=1000&offset=0&output_mode=json"),
+			),
+		},
+		{
+			name: "add field values",
+			tagFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				return nil, tag.AddFieldValues(ctx, c, "sourcetype::access_combined", "host::web01")
+			},
+			responseCode: http.StatusCreated,
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodPost),
+				checks.CheckRequestURL("/servicesNS/nobody/search/search/tags/web?output_mode=json"),
+				checks.CheckRequestBodyValue("add", "sourcetype::access_combined", "host::web01"),
+			),
+		},
+		{
+			name: "remove field values",
+			tagFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				return nil, tag.RemoveFieldValues(ctx, c, "host::web01")
+			},
+			responseCode: http.StatusCreated,
+			requestCheck: checks.ComposeCheckRequestFunc(
+				checks.CheckRequestMethod(http.MethodPost),
+				checks.CheckRequestURL("/servicesNS/nobody/search/search/tags/web?output_mode=json"),
+				checks.CheckRequestBodyValue("delete", "host::web01"),
+			),
+		},
+	}
+
+	for _, test := range tests {
+		server := checks.NewCheckRequestServer(t, test.requestCheck, test.responseCode, test.responseBody)
+		c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+		got, err := test.tagFunc(context.Background(), c)
+		server.Close()
+
+		if err != nil {
+			t.Errorf("%s: returned error: %s", test.name, err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got\n%#v, want\n%#v", test.name, got, test.want)
+		}
+	}
+}
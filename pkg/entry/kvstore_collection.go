@@ -0,0 +1,71 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"encoding/json"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// KVStoreCollectionContent is the content for a KVStoreCollection.
+type KVStoreCollectionContent struct {
+	// Fields maps field names to their types, such as "string", "number", "bool", "time", "cidr" or "array".
+	Fields attributes.Parameters `json:"-" values:"field,omitzero"`
+
+	// AcceleratedFields maps acceleration names to their JSON-encoded index definitions, such
+	// as `{"name": 1}`.
+	AcceleratedFields attributes.Parameters `json:"-" values:"accelerated_fields,omitzero"`
+
+	Disabled             attributes.Explicit[bool] `json:"disabled"             values:"disabled,omitzero"             selective:"read"`
+	EnforceTypes         attributes.Explicit[bool] `json:"enforceTypes"         values:"enforceTypes,omitzero"`
+	ProfilingEnabled     attributes.Explicit[bool] `json:"profilingEnabled"     values:"profilingEnabled,omitzero"`
+	ProfilingThresholdMs attributes.Explicit[int]  `json:"profilingThresholdMs" values:"profilingThresholdMs,omitzero"`
+	Replicate            attributes.Explicit[bool] `json:"replicate"            values:"replicate,omitzero"`
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling.
+func (content *KVStoreCollectionContent) UnmarshalJSON(data []byte) error {
+	type contentAlias KVStoreCollectionContent
+	var newAliasedContent contentAlias
+
+	if err := json.Unmarshal(data, &newAliasedContent); err != nil {
+		return err
+	}
+
+	fields, err := prefixedParameters(data, "field")
+	if err != nil {
+		return err
+	}
+	newAliasedContent.Fields = fields
+
+	acceleratedFields, err := prefixedParameters(data, "accelerated_fields")
+	if err != nil {
+		return err
+	}
+	newAliasedContent.AcceleratedFields = acceleratedFields
+
+	*content = KVStoreCollectionContent(newAliasedContent)
+
+	return nil
+}
+
+// KVStoreCollection is a KV store collection's configuration. The documents stored in a collection
+// are managed by the kvstore package.
+type KVStoreCollection struct {
+	ID      client.ID                `selective:"create" service:"storage/collections/config"`
+	Content KVStoreCollectionContent `json:"content" values:",anonymize"`
+}
@@ -0,0 +1,80 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+)
+
+func TestKVStoreCollection_UnmarshalJSON(t *testing.T) {
+	tests := checks.JSONUnmarshalTestCases{
+		{
+			Name:        "collection",
+			InputString: `{"id":"https://localhost:8089/servicesNS/nobody/search/storage/collections/config/state","content":{"field.name":"string","field.count":"number","accelerated_fields.by_name":"{\"name\": 1}","replicate":true,"disabled":false,"eai:acl":{"app":"search"}}}`,
+			Want: KVStoreCollection{
+				ID: mustParseID("https://localhost:8089/servicesNS/nobody/search/storage/collections/config/state"),
+				Content: KVStoreCollectionContent{
+					Fields:            attributes.Parameters{"name": "string", "count": "number"},
+					AcceleratedFields: attributes.Parameters{"by_name": `{"name": 1}`},
+					Replicate:         attributes.NewExplicit(true),
+					Disabled:          attributes.NewExplicit(false),
+				},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
+
+func TestKVStoreCollection_values(t *testing.T) {
+	collection := KVStoreCollection{
+		ID: client.ID{Title: "state"},
+		Content: KVStoreCollectionContent{
+			Fields:            attributes.Parameters{"name": "string"},
+			AcceleratedFields: attributes.Parameters{"by_name": `{"name": 1}`},
+			Replicate:         attributes.NewExplicit(true),
+			Disabled:          attributes.NewExplicit(true),
+		},
+	}
+
+	tests := checks.QueryValuesTestCases{
+		{
+			Name:  "create",
+			Input: checks.MustSelective(t, collection, "create"),
+			Want: url.Values{
+				"name":                       []string{"state"},
+				"field.name":                 []string{"string"},
+				"accelerated_fields.by_name": []string{`{"name": 1}`},
+				"replicate":                  []string{"true"},
+			},
+		},
+		{
+			Name:  "update",
+			Input: checks.MustSelective(t, collection, "update"),
+			Want: url.Values{
+				"field.name":                 []string{"string"},
+				"accelerated_fields.by_name": []string{`{"name": 1}`},
+				"replicate":                  []string{"true"},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
@@ -0,0 +1,120 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"encoding/json"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// LookupTableFileContent is the content for a LookupTableFile.
+type LookupTableFileContent struct {
+	// Data is the path of the file to upload as the lookup table's content. When creating or updating
+	// a LookupTableFile this must be a file in the Splunk instance's lookup staging directory,
+	// $SPLUNK_HOME/var/run/splunk/lookup_tmp. When read, it is the path of the lookup table file.
+	Data attributes.Explicit[string] `json:"eai:data" values:"eai:data,omitzero"`
+}
+
+// LookupTableFile is a lookup table file, such as a CSV file, stored in an app's lookups directory.
+// Its ID's Title is the file name, such as "users.csv".
+type LookupTableFile struct {
+	ID      client.ID              `selective:"create" service:"data/lookup-table-files"`
+	Content LookupTableFileContent `json:"content" values:",anonymize"`
+}
+
+// LookupDefinitionContent is the content for a LookupDefinition.
+type LookupDefinitionContent struct {
+	AllowCaching       attributes.Explicit[bool]   `json:"allow_caching"        values:"allow_caching,omitzero"`
+	BatchIndexQuery    attributes.Explicit[bool]   `json:"batch_index_query"    values:"batch_index_query,omitzero"`
+	CaseSensitiveMatch attributes.Explicit[bool]   `json:"case_sensitive_match" values:"case_sensitive_match,omitzero"`
+	Collection         attributes.Explicit[string] `json:"collection"           values:"collection,omitzero"`
+	DefaultMatch       attributes.Explicit[string] `json:"default_match"        values:"default_match,omitzero"`
+	Disabled           attributes.Explicit[bool]   `json:"disabled"             values:"disabled,omitzero"             selective:"read"`
+	ExternalCmd        attributes.Explicit[string] `json:"external_cmd"         values:"external_cmd,omitzero"`
+	ExternalType       attributes.Explicit[string] `json:"external_type"        values:"external_type,omitzero"`
+	FieldsList         attributes.Explicit[string] `json:"fields_list"          values:"fields_list,omitzero"`
+	Filename           attributes.Explicit[string] `json:"filename"             values:"filename,omitzero"`
+	Filter             attributes.Explicit[string] `json:"filter"               values:"filter,omitzero"`
+	MatchType          attributes.Explicit[string] `json:"match_type"           values:"match_type,omitzero"`
+	MaxMatches         attributes.Explicit[int]    `json:"max_matches"          values:"max_matches,omitzero"`
+	MaxOffsetSecs      attributes.Explicit[int]    `json:"max_offset_secs"      values:"max_offset_secs,omitzero"`
+	MinMatches         attributes.Explicit[int]    `json:"min_matches"          values:"min_matches,omitzero"`
+	MinOffsetSecs      attributes.Explicit[int]    `json:"min_offset_secs"      values:"min_offset_secs,omitzero"`
+	TimeField          attributes.Explicit[string] `json:"time_field"           values:"time_field,omitzero"`
+	TimeFormat         attributes.Explicit[string] `json:"time_format"          values:"time_format,omitzero"`
+}
+
+// LookupDefinition is a lookup definition, defined as a stanza in transforms.conf.
+type LookupDefinition struct {
+	ID      client.ID               `selective:"create" service:"data/transforms/lookups"`
+	Content LookupDefinitionContent `json:"content" values:",anonymize"`
+}
+
+// AutomaticLookupContent is the content for an AutomaticLookup.
+type AutomaticLookupContent struct {
+	// InputFields maps the lookup's input fields to the event fields they are matched against.
+	InputFields attributes.Parameters `json:"-" values:"lookup.field.input,omitzero"`
+
+	// OutputFields maps the lookup's output fields to the event fields they are written to.
+	OutputFields attributes.Parameters `json:"-" values:"lookup.field.output,omitzero"`
+
+	// Overwrite determines if output fields overwrite existing values of event fields.
+	Overwrite attributes.Explicit[bool] `json:"overwrite" values:"overwrite,omitzero"`
+
+	// Stanza is the props.conf stanza (sourcetype, source or host) the AutomaticLookup applies to.
+	Stanza attributes.Explicit[string] `json:"stanza" values:"stanza,omitzero" selective:"create"`
+
+	// Transform is the name of the LookupDefinition to use.
+	Transform attributes.Explicit[string] `json:"transform" values:"transform,omitzero"`
+
+	// Read-only fields are populated by results returned by the Splunk API, but
+	// are not settable by Create or Update operations.
+	Attribute attributes.Explicit[string] `json:"attribute" values:"-"`
+	Value     attributes.Explicit[string] `json:"value"     values:"-"`
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling.
+func (content *AutomaticLookupContent) UnmarshalJSON(data []byte) error {
+	type contentAlias AutomaticLookupContent
+	var newAliasedContent contentAlias
+
+	if err := json.Unmarshal(data, &newAliasedContent); err != nil {
+		return err
+	}
+
+	inputFields, err := prefixedParameters(data, "lookup.field.input")
+	if err != nil {
+		return err
+	}
+	newAliasedContent.InputFields = inputFields
+
+	outputFields, err := prefixedParameters(data, "lookup.field.output")
+	if err != nil {
+		return err
+	}
+	newAliasedContent.OutputFields = outputFields
+
+	*content = AutomaticLookupContent(newAliasedContent)
+
+	return nil
+}
+
+// AutomaticLookup is an automatic lookup, defined as a LOOKUP class in props.conf.
+type AutomaticLookup struct {
+	ID      client.ID              `selective:"create" service:"data/props/lookups"`
+	Content AutomaticLookupContent `json:"content" values:",anonymize"`
+}
@@ -0,0 +1,133 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+)
+
+func TestLookups_UnmarshalJSON(t *testing.T) {
+	tests := checks.JSONUnmarshalTestCases{
+		{
+			Name:        "lookup table file",
+			InputString: `{"id":"https://localhost:8089/servicesNS/nobody/search/data/lookup-table-files/users.csv","content":{"eai:data":"/opt/splunk/etc/apps/search/lookups/users.csv"}}`,
+			Want: LookupTableFile{
+				ID: mustParseID("https://localhost:8089/servicesNS/nobody/search/data/lookup-table-files/users.csv"),
+				Content: LookupTableFileContent{
+					Data: attributes.NewExplicit("/opt/splunk/etc/apps/search/lookups/users.csv"),
+				},
+			},
+		},
+		{
+			Name:        "automatic lookup",
+			InputString: `{"id":"https://localhost:8089/servicesNS/nobody/search/data/props/lookups/access_combined%20%3A%20LOOKUP-users","content":{"attribute":"LOOKUP-users","lookup.field.input.user":"user","lookup.field.output.role":"user_role","overwrite":false,"stanza":"access_combined","transform":"users","eai:acl":{"app":"search"}}}`,
+			Want: AutomaticLookup{
+				ID: mustParseID("https://localhost:8089/servicesNS/nobody/search/data/props/lookups/access_combined%20%3A%20LOOKUP-users"),
+				Content: AutomaticLookupContent{
+					InputFields:  attributes.Parameters{"user": "user"},
+					OutputFields: attributes.Parameters{"role": "user_role"},
+					Overwrite:    attributes.NewExplicit(false),
+					Stanza:       attributes.NewExplicit("access_combined"),
+					Transform:    attributes.NewExplicit("users"),
+					Attribute:    attributes.NewExplicit("LOOKUP-users"),
+				},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
+
+func TestLookups_values(t *testing.T) {
+	tableFile := LookupTableFile{
+		ID: client.ID{Title: "users.csv"},
+		Content: LookupTableFileContent{
+			Data: attributes.NewExplicit("/opt/splunk/var/run/splunk/lookup_tmp/users.csv"),
+		},
+	}
+
+	definition := LookupDefinition{
+		ID: client.ID{Title: "users"},
+		Content: LookupDefinitionContent{
+			Filename:           attributes.NewExplicit("users.csv"),
+			CaseSensitiveMatch: attributes.NewExplicit(false),
+			Disabled:           attributes.NewExplicit(false),
+		},
+	}
+
+	automaticLookup := AutomaticLookup{
+		ID: client.ID{Title: "users"},
+		Content: AutomaticLookupContent{
+			InputFields:  attributes.Parameters{"user": "user"},
+			OutputFields: attributes.Parameters{"role": "user_role"},
+			Stanza:       attributes.NewExplicit("access_combined"),
+			Transform:    attributes.NewExplicit("users"),
+		},
+	}
+
+	tests := checks.QueryValuesTestCases{
+		{
+			Name:  "lookup table file create",
+			Input: checks.MustSelective(t, tableFile, "create"),
+			Want: url.Values{
+				"name":     []string{"users.csv"},
+				"eai:data": []string{"/opt/splunk/var/run/splunk/lookup_tmp/users.csv"},
+			},
+		},
+		{
+			Name:  "lookup table file update",
+			Input: checks.MustSelective(t, tableFile, "update"),
+			Want: url.Values{
+				"eai:data": []string{"/opt/splunk/var/run/splunk/lookup_tmp/users.csv"},
+			},
+		},
+		{
+			Name:  "lookup definition create",
+			Input: checks.MustSelective(t, definition, "create"),
+			Want: url.Values{
+				"name":                 []string{"users"},
+				"filename":             []string{"users.csv"},
+				"case_sensitive_match": []string{"false"},
+			},
+		},
+		{
+			Name:  "automatic lookup create",
+			Input: checks.MustSelective(t, automaticLookup, "create"),
+			Want: url.Values{
+				"name":                     []string{"users"},
+				"lookup.field.input.user":  []string{"user"},
+				"lookup.field.output.role": []string{"user_role"},
+				"stanza":                   []string{"access_combined"},
+				"transform":                []string{"users"},
+			},
+		},
+		{
+			Name:  "automatic lookup update",
+			Input: checks.MustSelective(t, automaticLookup, "update"),
+			Want: url.Values{
+				"lookup.field.input.user":  []string{"user"},
+				"lookup.field.output.role": []string{"user_role"},
+				"transform":                []string{"users"},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
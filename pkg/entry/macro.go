@@ -0,0 +1,38 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// MacroContent is the content for a Macro.
+type MacroContent struct {
+	Args        attributes.Explicit[string] `json:"args"        values:"args,omitzero"`
+	Definition  attributes.Explicit[string] `json:"definition"  values:"definition,omitzero"`
+	Description attributes.Explicit[string] `json:"description" values:"description,omitzero"`
+	Disabled    attributes.Explicit[bool]   `json:"disabled"    values:"disabled,omitzero"    selective:"read"`
+	ErrorMsg    attributes.Explicit[string] `json:"errormsg"    values:"errormsg,omitzero"`
+	IsEval      attributes.Explicit[bool]   `json:"iseval"      values:"iseval,omitzero"`
+	Validation  attributes.Explicit[string] `json:"validation"  values:"validation,omitzero"`
+}
+
+// Macro is a search macro. Macros that take arguments are named with their argument count,
+// such as "my_macro(2)".
+type Macro struct {
+	ID      client.ID    `selective:"create" service:"admin/macros"`
+	Content MacroContent `json:"content" values:",anonymize"`
+}
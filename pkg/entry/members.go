@@ -0,0 +1,43 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"context"
+
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// member is a minimal entry used to list the members of one of an entry's sub-resources.
+type member struct {
+	ID client.ID
+}
+
+// listMembers returns the titles of the entries listed by the given action of an entry, requesting
+// them one page at a time.
+func listMembers(ctx context.Context, c *client.Client, entry interface{}, action string) ([]string, error) {
+	var members []member
+
+	if err := c.ListEntryActionContext(ctx, &members, entry, action); err != nil {
+		return nil, err
+	}
+
+	titles := make([]string, len(members))
+	for i, member := range members {
+		titles[i] = member.ID.Title
+	}
+
+	return titles, nil
+}
@@ -0,0 +1,90 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/authenticators"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+func TestListMembers_paging(t *testing.T) {
+	ns := client.Namespace{User: "nobody", App: "search"}
+
+	tests := []struct {
+		name      string
+		inputFunc func(context.Context, *client.Client) ([]string, error)
+		wantPath  string
+	}{
+		{
+			name: "monitor members",
+			inputFunc: func(ctx context.Context, c *client.Client) ([]string, error) {
+				return MonitorInput{ID: client.ID{Namespace: ns, Title: "/var/log"}}.Members(ctx, c)
+			},
+			wantPath: "/servicesNS/nobody/search/data/inputs/monitor/%2Fvar%2Flog/members",
+		},
+		{
+			name: "tcp cooked connections",
+			inputFunc: func(ctx context.Context, c *client.Client) ([]string, error) {
+				return TCPCookedInput{ID: client.ID{Namespace: ns, Title: "9997"}}.Connections(ctx, c)
+			},
+			wantPath: "/servicesNS/nobody/search/data/inputs/tcp/cooked/9997/connections",
+		},
+		{
+			name: "tag field values",
+			inputFunc: func(ctx context.Context, c *client.Client) ([]string, error) {
+				return Tag{ID: client.ID{Namespace: ns, Title: "web"}}.FieldValues(ctx, c)
+			},
+			wantPath: "/servicesNS/nobody/search/search/tags/web",
+		},
+	}
+
+	for _, test := range tests {
+		var gotPaths []string
+
+		// serves three members, one per page, regardless of the requested page size
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotPaths = append(gotPaths, r.URL.EscapedPath())
+			offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+			fmt.Fprintf(w, `{"paging":{"total":3,"perPage":1,"offset":%d},"entry":[{"id":"https://localhost:8089/servicesNS/nobody/search/member/member%d"}]}`, offset, offset)
+		}))
+		c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+		got, err := test.inputFunc(context.Background(), c)
+		server.Close()
+
+		if err != nil {
+			t.Errorf("%s: returned error: %s", test.name, err)
+		}
+
+		want := []string{"member0", "member1", "member2"}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got\n%#v, want\n%#v", test.name, got, want)
+		}
+
+		wantPaths := []string{test.wantPath, test.wantPath, test.wantPath}
+		if !reflect.DeepEqual(gotPaths, wantPaths) {
+			t.Errorf("%s: got paths\n%#v, want\n%#v", test.name, gotPaths, wantPaths)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"context"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// MonitorInputContent is the content for a MonitorInput.
+type MonitorInputContent struct {
+	Blacklist       attributes.Explicit[string] `json:"blacklist"         values:"blacklist,omitzero"`
+	CrcSalt         attributes.Explicit[string] `json:"crcSalt"           values:"crc-salt,omitzero"`
+	Disabled        attributes.Explicit[bool]   `json:"disabled"          values:"disabled,omitzero"          selective:"read"`
+	FollowTail      attributes.Explicit[bool]   `json:"followTail"        values:"followTail,omitzero"`
+	Host            attributes.Explicit[string] `json:"host"              values:"host,omitzero"`
+	HostRegex       attributes.Explicit[string] `json:"host_regex"        values:"host_regex,omitzero"`
+	HostSegment     attributes.Explicit[int]    `json:"host_segment"      values:"host_segment,omitzero"`
+	IgnoreOlderThan attributes.Explicit[string] `json:"ignoreOlderThan"   values:"ignore-older-than,omitzero"`
+	Index           attributes.Explicit[string] `json:"index"             values:"index,omitzero"`
+	Recursive       attributes.Explicit[bool]   `json:"recursive"         values:"recursive,omitzero"`
+	RenameSource    attributes.Explicit[string] `json:"source"            values:"rename-source,omitzero"`
+	SourceType      attributes.Explicit[string] `json:"sourcetype"        values:"sourcetype,omitzero"`
+	TimeBeforeClose attributes.Explicit[int]    `json:"time_before_close" values:"time-before-close,omitzero"`
+	Whitelist       attributes.Explicit[string] `json:"whitelist"         values:"whitelist,omitzero"`
+}
+
+// MonitorInput is a Splunk file or directory monitor input. Its Title is the monitored path.
+// MonitorInputs can be enabled and disabled with Client.Enable and Client.Disable.
+type MonitorInput struct {
+	ID      client.ID           `selective:"create" service:"data/inputs/monitor"`
+	Content MonitorInputContent `json:"content" values:",anonymize"`
+}
+
+// Members returns the paths of the files being monitored by the MonitorInput.
+func (input MonitorInput) Members(ctx context.Context, c *client.Client) ([]string, error) {
+	return listMembers(ctx, c, input, "members")
+}
@@ -0,0 +1,48 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+)
+
+// prefixedParameters returns the string values of the JSON object in data whose keys begin with
+// the given prefix and a dot, keyed by the remainder of the key. Values of other types, such as the
+// eai:acl object present in entry content, are ignored. It returns nil if no such values exist.
+func prefixedParameters(data []byte, prefix string) (attributes.Parameters, error) {
+	allValues := map[string]interface{}{}
+	if err := json.Unmarshal(data, &allValues); err != nil {
+		return nil, err
+	}
+
+	var params attributes.Parameters
+	for key, value := range allValues {
+		paramName := strings.TrimPrefix(key, prefix+".")
+		stringValue, ok := value.(string)
+		if paramName == key || !ok {
+			continue
+		}
+
+		if params == nil {
+			params = attributes.Parameters{}
+		}
+		params[paramName] = stringValue
+	}
+
+	return params, nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// ScriptInputContent is the content for a ScriptInput.
+type ScriptInputContent struct {
+	Disabled     attributes.Explicit[bool]   `json:"disabled"   values:"disabled,omitzero"      selective:"read"`
+	Host         attributes.Explicit[string] `json:"host"       values:"host,omitzero"`
+	Index        attributes.Explicit[string] `json:"index"      values:"index,omitzero"`
+	Interval     attributes.Explicit[string] `json:"interval"   values:"interval,omitzero"`
+	PassAuth     attributes.Explicit[string] `json:"passAuth"   values:"passAuth,omitzero"`
+	RenameSource attributes.Explicit[string] `json:"source"     values:"rename-source,omitzero"`
+	SourceType   attributes.Explicit[string] `json:"sourcetype" values:"sourcetype,omitzero"`
+}
+
+// ScriptInput is a Splunk scripted input. Its Title is the path to the script. ScriptInputs can be
+// enabled and disabled with Client.Enable and Client.Disable.
+type ScriptInput struct {
+	ID      client.ID          `selective:"create" service:"data/inputs/script"`
+	Content ScriptInputContent `json:"content" values:",anonymize"`
+}
+
+// scriptRestart is the data/inputs/script/restart service.
+type scriptRestart struct {
+	Namespace client.Namespace `service:"data/inputs/script/restart" values:"-"`
+	Script    string           `values:"script"`
+}
+
+// Restart restarts the ScriptInput's script.
+func (input ScriptInput) Restart(ctx context.Context, c *client.Client) error {
+	restart := scriptRestart{Namespace: input.ID.Namespace, Script: input.ID.Title}
+
+	return c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestServiceURL(c, restart),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestBodyValues(restart),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+		),
+	)
+}
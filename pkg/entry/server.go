@@ -0,0 +1,182 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// ServerInfoContent is the content for ServerInfo.
+type ServerInfoContent struct {
+	ActiveLicenseGroup attributes.Explicit[string] `json:"activeLicenseGroup" values:"-"`
+	Build              attributes.Explicit[string] `json:"build"              values:"-"`
+	CPUArch            attributes.Explicit[string] `json:"cpu_arch"           values:"-"`
+	GUID               attributes.Explicit[string] `json:"guid"               values:"-"`
+	HealthInfo         attributes.Explicit[string] `json:"health_info"        values:"-"`
+	Host               attributes.Explicit[string] `json:"host"               values:"-"`
+	IsFree             attributes.Explicit[bool]   `json:"isFree"             values:"-"`
+	IsTrial            attributes.Explicit[bool]   `json:"isTrial"            values:"-"`
+	KVStoreStatus      attributes.Explicit[string] `json:"kvStoreStatus"      values:"-"`
+	LicenseLabels      []string                    `json:"license_labels"     values:"-"`
+	LicenseState       attributes.Explicit[string] `json:"licenseState"       values:"-"`
+	Mode               attributes.Explicit[string] `json:"mode"               values:"-"`
+	NumberOfCores      attributes.Explicit[int]    `json:"numberOfCores"      values:"-"`
+	OSName             attributes.Explicit[string] `json:"os_name"            values:"-"`
+	PhysicalMemoryMB   attributes.Explicit[int]    `json:"physicalMemoryMB"   values:"-"`
+	ProductType        attributes.Explicit[string] `json:"product_type"       values:"-"`
+	ServerName         attributes.Explicit[string] `json:"serverName"         values:"-"`
+	ServerRoles        []string                    `json:"server_roles"       values:"-"`
+	StartupTime        attributes.Explicit[int]    `json:"startup_time"       values:"-"`
+	Version            attributes.Explicit[string] `json:"version"            values:"-"`
+}
+
+// HasServerRole returns true if the ServerInfo's ServerRoles contains role, such as "indexer"
+// or "cluster_master".
+func (content ServerInfoContent) HasServerRole(role string) bool {
+	for _, serverRole := range content.ServerRoles {
+		if serverRole == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ServerInfo is the read-only information about a Splunk instance, such as its version, server roles
+// and license state. It can only be managed with Client.Read.
+type ServerInfo struct {
+	ID      client.SingletonID `service:"server/info"`
+	Content ServerInfoContent  `json:"content"`
+}
+
+// HealthFeature is a feature reported by the splunkd health report. Features may contain other features.
+type HealthFeature struct {
+	// Health is the feature's health color, "green", "yellow" or "red".
+	Health   string                   `json:"health"`
+	Features map[string]HealthFeature `json:"features"`
+
+	// Reasons are the raw reasons reported for an unhealthy feature, keyed by health color.
+	Reasons map[string]json.RawMessage `json:"reasons"`
+}
+
+// UnhealthyFeatures returns the names of all features beneath the HealthFeature, at any depth, whose
+// Health isn't "green". Nested feature names are returned as their path, such as
+// []string{"Index Processor", "Buckets"}. The returned paths are sorted.
+func (feature HealthFeature) UnhealthyFeatures() [][]string {
+	var unhealthy [][]string
+
+	names := make([]string, 0, len(feature.Features))
+	for name := range feature.Features {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		child := feature.Features[name]
+		if child.Health != "green" {
+			unhealthy = append(unhealthy, []string{name})
+		}
+
+		for _, childPath := range child.UnhealthyFeatures() {
+			unhealthy = append(unhealthy, append([]string{name}, childPath...))
+		}
+	}
+
+	return unhealthy
+}
+
+// SplunkdHealth is the read-only splunkd health report. Its Content is the top-level HealthFeature.
+// It can only be managed with Client.Read.
+type SplunkdHealth struct {
+	ID      client.SingletonID `service:"server/health/splunkd/details"`
+	Content HealthFeature      `json:"content"`
+}
+
+// ServerStatus is a status category listed by server/status, such as "resource-usage" or
+// "partitions-space". ServerStatus entries have no content, and can only be managed with
+// Client.List.
+type ServerStatus struct {
+	ID client.ID `service:"server/status"`
+}
+
+// ServerSettingsContent is the content for ServerSettings.
+type ServerSettingsContent struct {
+	EnableSplunkWebSSL attributes.Explicit[bool]   `json:"enableSplunkWebSSL" values:"-"`
+	Host               attributes.Explicit[string] `json:"host"               values:"-"`
+	HTTPPort           attributes.Explicit[int]    `json:"httpport"           values:"-"`
+	MgmtHostPort       attributes.Explicit[string] `json:"mgmtHostPort"       values:"-"`
+	MinFreeSpace       attributes.Explicit[int]    `json:"minFreeSpace"       values:"-"`
+	ServerName         attributes.Explicit[string] `json:"serverName"         values:"-"`
+	SessionTimeout     attributes.Explicit[string] `json:"sessionTimeout"     values:"-"`
+	SplunkDB           attributes.Explicit[string] `json:"SPLUNK_DB"          values:"-"`
+	SplunkHome         attributes.Explicit[string] `json:"SPLUNK_HOME"        values:"-"`
+	StartWebServer     attributes.Explicit[bool]   `json:"startwebserver"     values:"-"`
+	TrustedIP          attributes.Explicit[string] `json:"trustedIP"          values:"-"`
+}
+
+// ServerSettings are the read-only server settings of a Splunk instance. They can only be managed
+// with Client.Read.
+type ServerSettings struct {
+	ID      client.SingletonID    `service:"server/settings/settings"`
+	Content ServerSettingsContent `json:"content"`
+}
+
+// BulletinMessageContent is the content for a BulletinMessage.
+type BulletinMessageContent struct {
+	// Message is the text of the message. The Splunk API returns it keyed by the message's title,
+	// so it is populated by BulletinMessage's UnmarshalJSON.
+	Message attributes.Explicit[string] `json:"-" values:"-"`
+
+	Help        attributes.Explicit[string] `json:"help"                  values:"-"`
+	Server      attributes.Explicit[string] `json:"server"                values:"-"`
+	Severity    attributes.Explicit[string] `json:"severity"              values:"-"`
+	TimeCreated attributes.Explicit[int]    `json:"timeCreated_epochSecs" values:"-"`
+}
+
+// BulletinMessage is a bulletin message shown in Splunk Web, such as a notice that a restart is
+// required. BulletinMessages can be managed with Client.List and Client.Read.
+type BulletinMessage struct {
+	ID      client.ID              `service:"messages"`
+	Content BulletinMessageContent `json:"content"`
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling.
+func (message *BulletinMessage) UnmarshalJSON(data []byte) error {
+	type messageAlias BulletinMessage
+	var newAliasedMessage messageAlias
+
+	if err := json.Unmarshal(data, &newAliasedMessage); err != nil {
+		return err
+	}
+
+	var rawContent struct {
+		Content map[string]interface{} `json:"content"`
+	}
+	if err := json.Unmarshal(data, &rawContent); err != nil {
+		return err
+	}
+
+	if text, ok := rawContent.Content[newAliasedMessage.ID.Title].(string); ok {
+		newAliasedMessage.Content.Message = attributes.NewExplicit(text)
+	}
+
+	*message = BulletinMessage(newAliasedMessage)
+
+	return nil
+}
@@ -0,0 +1,147 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/authenticators"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+)
+
+func TestServer_UnmarshalJSON(t *testing.T) {
+	tests := checks.JSONUnmarshalTestCases{
+		{
+			Name:        "server info",
+			InputString: `{"id":"https://localhost:8089/services/server/info/server-info","content":{"version":"9.0.0","server_roles":["indexer","license_master"],"licenseState":"OK","numberOfCores":8,"isFree":false,"eai:acl":{"app":""}}}`,
+			Want: ServerInfo{
+				Content: ServerInfoContent{
+					Version:       attributes.NewExplicit("9.0.0"),
+					ServerRoles:   []string{"indexer", "license_master"},
+					LicenseState:  attributes.NewExplicit("OK"),
+					NumberOfCores: attributes.NewExplicit(8),
+					IsFree:        attributes.NewExplicit(false),
+				},
+			},
+		},
+		{
+			Name:        "splunkd health",
+			InputString: `{"id":"https://localhost:8089/services/server/health/splunkd/details","content":{"health":"yellow","features":{"Index Processor":{"health":"yellow","features":{"Buckets":{"health":"yellow","reasons":{"yellow":{"reason":"too many buckets"}}}}}}}}`,
+			Want: SplunkdHealth{
+				Content: HealthFeature{
+					Health: "yellow",
+					Features: map[string]HealthFeature{
+						"Index Processor": {
+							Health: "yellow",
+							Features: map[string]HealthFeature{
+								"Buckets": {
+									Health:  "yellow",
+									Reasons: map[string]json.RawMessage{"yellow": json.RawMessage(`{"reason":"too many buckets"}`)},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "bulletin message",
+			InputString: `{"id":"https://localhost:8089/services/messages/restart_required","content":{"restart_required":"Splunk must be restarted for changes to take effect.","severity":"warn","timeCreated_epochSecs":1660000000,"help":""}}`,
+			Want: BulletinMessage{
+				ID: mustParseID("https://localhost:8089/services/messages/restart_required"),
+				Content: BulletinMessageContent{
+					Message:     attributes.NewExplicit("Splunk must be restarted for changes to take effect."),
+					Severity:    attributes.NewExplicit("warn"),
+					TimeCreated: attributes.NewExplicit(1660000000),
+					Help:        attributes.NewExplicit(""),
+				},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
+
+func TestHealthFeature_UnhealthyFeatures(t *testing.T) {
+	tests := []struct {
+		name  string
+		input HealthFeature
+		want  [][]string
+	}{
+		{
+			name:  "healthy",
+			input: HealthFeature{Health: "green", Features: map[string]HealthFeature{"Search Scheduler": {Health: "green"}}},
+		},
+		{
+			name: "nested",
+			input: HealthFeature{
+				Health: "red",
+				Features: map[string]HealthFeature{
+					"Search Scheduler": {Health: "green"},
+					"Index Processor": {
+						Health: "red",
+						Features: map[string]HealthFeature{
+							"Buckets":   {Health: "red"},
+							"Disk Full": {Health: "green"},
+						},
+					},
+					"File Monitor Input": {Health: "yellow"},
+				},
+			},
+			want: [][]string{
+				{"File Monitor Input"},
+				{"Index Processor"},
+				{"Index Processor", "Buckets"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		got := test.input.UnhealthyFeatures()
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: UnhealthyFeatures() got\n%#v, want\n%#v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestServerInfo_Read(t *testing.T) {
+	server := checks.NewCheckRequestServer(
+		t,
+		checks.ComposeCheckRequestFunc(
+			checks.CheckRequestMethod(http.MethodGet),
+			checks.CheckRequestURL("/services/server/info?output_mode=json"),
+		),
+		http.StatusOK,
+		`{"entry":[{"id":"https://localhost:8089/services/server/info/server-info","content":{"version":"9.0.0","server_roles":["search_head"]}}]}`,
+	)
+	defer server.Close()
+
+	c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+	info := ServerInfo{}
+	if err := c.Read(&info); err != nil {
+		t.Fatalf("Read() returned error: %s", err)
+	}
+
+	if !info.Content.HasServerRole("search_head") || info.Content.HasServerRole("indexer") {
+		t.Errorf("HasServerRole() got unexpected results for server roles %v", info.Content.ServerRoles)
+	}
+}
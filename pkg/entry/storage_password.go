@@ -0,0 +1,39 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// StoragePasswordContent is the content for a StoragePassword.
+type StoragePasswordContent struct {
+	// Password is the stored secret. When a StoragePassword is read, Password is populated from the
+	// decrypted clear_password returned by the Splunk API, so that Diff and Apply compare the actual
+	// stored value. Password is redacted when formatted.
+	Password attributes.Secret `json:"clear_password" values:"password,omitzero"`
+
+	// Read-only fields are populated by results returned by the Splunk API, but
+	// are not settable by Create or Update operations.
+	EncryptedPassword attributes.Explicit[string] `json:"encr_password" values:"-"`
+}
+
+// StoragePassword is a credential stored in Splunk's storage/passwords endpoint. It is identified
+// by the Realm and Username of its PasswordID. The Realm may be empty, but the Username may not.
+type StoragePassword struct {
+	ID      client.PasswordID      `selective:"create" service:"storage/passwords"`
+	Content StoragePasswordContent `json:"content" values:",anonymize"`
+}
@@ -0,0 +1,91 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+)
+
+func TestStoragePassword_UnmarshalJSON(t *testing.T) {
+	tests := checks.JSONUnmarshalTestCases{
+		{
+			Name:        "password",
+			InputString: `{"id":"https://localhost:8089/servicesNS/nobody/search/storage/passwords/my_realm%3Aapi_user%3A","content":{"clear_password":"changeme","encr_password":"$7$abc","password":"********","realm":"my_realm","username":"api_user"}}`,
+			Want: StoragePassword{
+				ID: client.PasswordID{
+					Namespace: client.Namespace{User: "nobody", App: "search"},
+					Realm:     "my_realm",
+					Username:  "api_user",
+				},
+				Content: StoragePasswordContent{
+					Password:          "changeme",
+					EncryptedPassword: attributes.NewExplicit("$7$abc"),
+				},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
+
+func TestStoragePassword_values(t *testing.T) {
+	password := StoragePassword{
+		ID: client.PasswordID{Realm: "my_realm", Username: "api_user"},
+		Content: StoragePasswordContent{
+			Password: "changeme",
+		},
+	}
+
+	tests := checks.QueryValuesTestCases{
+		{
+			Name:  "create",
+			Input: checks.MustSelective(t, password, "create"),
+			Want: url.Values{
+				"name":     []string{"api_user"},
+				"realm":    []string{"my_realm"},
+				"password": []string{"changeme"},
+			},
+		},
+		{
+			Name:  "update",
+			Input: checks.MustSelective(t, password, "update"),
+			Want: url.Values{
+				"password": []string{"changeme"},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
+
+func TestStoragePassword_Format(t *testing.T) {
+	password := StoragePassword{
+		ID:      client.PasswordID{Realm: "my_realm", Username: "api_user"},
+		Content: StoragePasswordContent{Password: "changeme"},
+	}
+
+	for _, format := range []string{"%v", "%+v", "%#v"} {
+		if got := fmt.Sprintf(format, password); strings.Contains(got, "changeme") {
+			t.Errorf("fmt.Sprintf(%q) got %s, containing the password", format, got)
+		}
+	}
+}
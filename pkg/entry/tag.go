@@ -0,0 +1,89 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// Tag is a Splunk tag. A Tag has no content of its own, and is instead defined by the field::value
+// pairs it is applied to. Tags are created by adding field::value pairs to them with AddFieldValues,
+// and are deleted with Client.Delete, which removes the Tag from all field::value pairs.
+type Tag struct {
+	ID client.ID `service:"search/tags"`
+}
+
+// tagFieldValue is a field::value pair a Tag is applied to, as listed beneath the Tag.
+type tagFieldValue struct {
+	ID client.ID `service:"search/tags"`
+}
+
+// tagUpdate is the request sent to add or remove field::value pairs for a Tag.
+type tagUpdate struct {
+	Add    []string `values:"add,omitzero"`
+	Delete []string `values:"delete,omitzero"`
+}
+
+// FieldValues returns the field::value pairs the Tag is applied to.
+func (tag Tag) FieldValues(ctx context.Context, c *client.Client) ([]string, error) {
+	var fieldValues []tagFieldValue
+
+	if err := c.ListIDContext(ctx, &fieldValues, tag.ID); err != nil {
+		return nil, err
+	}
+
+	titles := make([]string, len(fieldValues))
+	for i, fieldValue := range fieldValues {
+		titles[i] = fieldValue.ID.Title
+	}
+
+	return titles, nil
+}
+
+// AddFieldValues applies the Tag to the given field::value pairs, creating the Tag if it doesn't
+// already exist.
+func (tag Tag) AddFieldValues(ctx context.Context, c *client.Client, fieldValues ...string) error {
+	return tag.update(ctx, c, tagUpdate{Add: fieldValues})
+}
+
+// RemoveFieldValues removes the Tag from the given field::value pairs.
+func (tag Tag) RemoveFieldValues(ctx context.Context, c *client.Client, fieldValues ...string) error {
+	return tag.update(ctx, c, tagUpdate{Delete: fieldValues})
+}
+
+// update performs the given tagUpdate for the Tag.
+func (tag Tag) update(ctx context.Context, c *client.Client, update tagUpdate) error {
+	return c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestEntryURL(c, tag),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestBodyValues(update),
+			client.BuildRequestAuthenticate(c),
+		),
+		func(r *http.Response) error {
+			// the tag is created by its first update, which may be reported as either 200 or 201
+			if r.StatusCode == http.StatusOK || r.StatusCode == http.StatusCreated {
+				return nil
+			}
+
+			return client.HandleResponseJSONMessagesError()(r)
+		},
+	)
+}
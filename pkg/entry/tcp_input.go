@@ -0,0 +1,67 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"context"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// TCPRawInputContent is the content for a TCPRawInput.
+type TCPRawInputContent struct {
+	ConnectionHost    attributes.Explicit[string] `json:"connection_host"   values:"connection_host,omitzero"`
+	Disabled          attributes.Explicit[bool]   `json:"disabled"          values:"disabled,omitzero"          selective:"read"`
+	Host              attributes.Explicit[string] `json:"host"              values:"host,omitzero"`
+	Index             attributes.Explicit[string] `json:"index"             values:"index,omitzero"`
+	Queue             attributes.Explicit[string] `json:"queue"             values:"queue,omitzero"`
+	RawTCPDoneTimeout attributes.Explicit[int]    `json:"rawTcpDoneTimeout" values:"rawTcpDoneTimeout,omitzero"`
+	RestrictToHost    attributes.Explicit[string] `json:"restrictToHost"    values:"restrictToHost,omitzero"    selective:"create"`
+	Source            attributes.Explicit[string] `json:"source"            values:"source,omitzero"`
+	SourceType        attributes.Explicit[string] `json:"sourcetype"        values:"sourcetype,omitzero"`
+}
+
+// TCPRawInput is a Splunk input for raw TCP data. Its Title is the port it listens on. TCPRawInputs
+// can be enabled and disabled with Client.Enable and Client.Disable.
+type TCPRawInput struct {
+	ID      client.ID          `selective:"create" service:"data/inputs/tcp/raw"`
+	Content TCPRawInputContent `json:"content" values:",anonymize"`
+}
+
+// Connections returns the hosts connected to the TCPRawInput, as listed by its "connects" endpoint.
+func (input TCPRawInput) Connections(ctx context.Context, c *client.Client) ([]string, error) {
+	return listMembers(ctx, c, input, "connects")
+}
+
+// TCPCookedInputContent is the content for a TCPCookedInput.
+type TCPCookedInputContent struct {
+	ConnectionHost attributes.Explicit[string] `json:"connection_host" values:"connection_host,omitzero"`
+	Disabled       attributes.Explicit[bool]   `json:"disabled"        values:"disabled,omitzero"        selective:"read"`
+	Host           attributes.Explicit[string] `json:"host"            values:"host,omitzero"`
+	RestrictToHost attributes.Explicit[string] `json:"restrictToHost"  values:"restrictToHost,omitzero"  selective:"create"`
+}
+
+// TCPCookedInput is a Splunk input for data forwarded by other Splunk instances. Its Title is the
+// port it listens on. TCPCookedInputs can be enabled and disabled with Client.Enable and Client.Disable.
+type TCPCookedInput struct {
+	ID      client.ID             `selective:"create" service:"data/inputs/tcp/cooked"`
+	Content TCPCookedInputContent `json:"content" values:",anonymize"`
+}
+
+// Connections returns the hosts connected to the TCPCookedInput.
+func (input TCPCookedInput) Connections(ctx context.Context, c *client.Client) ([]string, error) {
+	return listMembers(ctx, c, input, "connections")
+}
@@ -0,0 +1,41 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// UDPInputContent is the content for a UDPInput.
+type UDPInputContent struct {
+	ConnectionHost       attributes.Explicit[string] `json:"connection_host"        values:"connection_host,omitzero"`
+	Disabled             attributes.Explicit[bool]   `json:"disabled"               values:"disabled,omitzero"               selective:"read"`
+	Host                 attributes.Explicit[string] `json:"host"                   values:"host,omitzero"`
+	Index                attributes.Explicit[string] `json:"index"                  values:"index,omitzero"`
+	NoAppendingTimestamp attributes.Explicit[bool]   `json:"no_appending_timestamp" values:"no_appending_timestamp,omitzero"`
+	NoPriorityStripping  attributes.Explicit[bool]   `json:"no_priority_stripping"  values:"no_priority_stripping,omitzero"`
+	Queue                attributes.Explicit[string] `json:"queue"                  values:"queue,omitzero"`
+	RestrictToHost       attributes.Explicit[string] `json:"restrictToHost"         values:"restrictToHost,omitzero"         selective:"create"`
+	Source               attributes.Explicit[string] `json:"source"                 values:"source,omitzero"`
+	SourceType           attributes.Explicit[string] `json:"sourcetype"             values:"sourcetype,omitzero"`
+}
+
+// UDPInput is a Splunk input for UDP data. Its Title is the port it listens on. UDPInputs can be
+// enabled and disabled with Client.Enable and Client.Disable.
+type UDPInput struct {
+	ID      client.ID       `selective:"create" service:"data/inputs/udp"`
+	Content UDPInputContent `json:"content" values:",anonymize"`
+}
@@ -0,0 +1,154 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// ErrNotDashboardStudio is returned when attempting to get the Dashboard Studio definition of a
+// View that isn't a Dashboard Studio dashboard.
+var ErrNotDashboardStudio = errors.New("entry: view is not a Dashboard Studio dashboard")
+
+// dashboardStudioVersion is the dashboard version attribute that identifies a Dashboard Studio dashboard.
+const dashboardStudioVersion = "2"
+
+// ViewContent is the content for a View.
+type ViewContent struct {
+	// Data is the view's definition. For Simple XML dashboards this is the dashboard's XML. For
+	// Dashboard Studio dashboards it is an XML document wrapping the JSON definition, which can be
+	// created with DashboardStudioData.
+	Data     attributes.Explicit[string] `json:"eai:data" values:"eai:data,omitzero"`
+	Disabled attributes.Explicit[bool]   `json:"disabled" values:"disabled,omitzero" selective:"read"`
+
+	// Read-only fields are populated by results returned by the Splunk API, but
+	// are not settable by Create or Update operations.
+	IsDashboard attributes.Explicit[bool]   `json:"isDashboard" values:"-"`
+	IsVisible   attributes.Explicit[bool]   `json:"isVisible"   values:"-"`
+	Label       attributes.Explicit[string] `json:"label"       values:"-"`
+	RootNode    attributes.Explicit[string] `json:"rootNode"    values:"-"`
+	Version     attributes.Explicit[string] `json:"version"     values:"-"`
+}
+
+// dashboardStudioXML is the XML document that wraps a Dashboard Studio definition.
+type dashboardStudioXML struct {
+	XMLName    xml.Name `xml:"dashboard"`
+	Version    string   `xml:"version,attr"`
+	Theme      string   `xml:"theme,attr,omitempty"`
+	Label      string   `xml:"label"`
+	Definition string   `xml:"definition"`
+}
+
+// DashboardStudioData returns the View Data for a Dashboard Studio dashboard with the given label,
+// theme and JSON definition. theme may be empty to use the default theme.
+func DashboardStudioData(label string, theme string, definition json.RawMessage) (string, error) {
+	compacted := &bytes.Buffer{}
+	if err := json.Compact(compacted, definition); err != nil {
+		return "", fmt.Errorf("entry: unable to compact dashboard definition: %s", err)
+	}
+
+	// "]]>" can only occur within JSON strings, where it can be safely escaped to avoid terminating
+	// the CDATA section.
+	escapedDefinition := strings.ReplaceAll(compacted.String(), "]]>", `]]\u003e`)
+
+	labelXML := &strings.Builder{}
+	if err := xml.EscapeText(labelXML, []byte(label)); err != nil {
+		return "", err
+	}
+
+	data := &strings.Builder{}
+	fmt.Fprintf(data, `<dashboard version="%s"`, dashboardStudioVersion)
+	if theme != "" {
+		themeXML := &strings.Builder{}
+		if err := xml.EscapeText(themeXML, []byte(theme)); err != nil {
+			return "", err
+		}
+		fmt.Fprintf(data, ` theme="%s"`, themeXML)
+	}
+	fmt.Fprintf(data, "><label>%s</label><definition><![CDATA[%s]]></definition></dashboard>", labelXML, escapedDefinition)
+
+	return data.String(), nil
+}
+
+// IsDashboardStudio returns true if the ViewContent's Data is a Dashboard Studio dashboard.
+func (content ViewContent) IsDashboardStudio() bool {
+	dashboard, err := content.dashboardStudioXML()
+
+	return err == nil && dashboard.Version == dashboardStudioVersion
+}
+
+// DashboardStudioDefinition returns the JSON definition of a Dashboard Studio dashboard. It returns
+// ErrNotDashboardStudio if the ViewContent's Data isn't a Dashboard Studio dashboard.
+func (content ViewContent) DashboardStudioDefinition() (json.RawMessage, error) {
+	dashboard, err := content.dashboardStudioXML()
+	if err != nil || dashboard.Version != dashboardStudioVersion {
+		return nil, ErrNotDashboardStudio
+	}
+
+	definition := json.RawMessage(strings.TrimSpace(dashboard.Definition))
+	if !json.Valid(definition) {
+		return nil, fmt.Errorf("entry: dashboard definition is not valid JSON")
+	}
+
+	return definition, nil
+}
+
+// dashboardStudioXML decodes the ViewContent's Data as a dashboardStudioXML.
+func (content ViewContent) dashboardStudioXML() (dashboardStudioXML, error) {
+	var dashboard dashboardStudioXML
+	err := xml.Unmarshal([]byte(content.Data.Value()), &dashboard)
+
+	return dashboard, err
+}
+
+// View is a view, such as a Simple XML or Dashboard Studio dashboard.
+type View struct {
+	ID      client.ID   `selective:"create" service:"data/ui/views"`
+	Content ViewContent `json:"content" values:",anonymize"`
+}
+
+// NavContent is the content for a Nav.
+type NavContent struct {
+	// Data is the navigation menu's XML definition.
+	Data     attributes.Explicit[string] `json:"eai:data" values:"eai:data,omitzero"`
+	Disabled attributes.Explicit[bool]   `json:"disabled" values:"disabled,omitzero" selective:"read"`
+}
+
+// Nav is an app's navigation menu. An app's default navigation menu is titled "default".
+type Nav struct {
+	ID      client.ID  `selective:"create" service:"data/ui/nav"`
+	Content NavContent `json:"content" values:",anonymize"`
+}
+
+// PanelContent is the content for a Panel.
+type PanelContent struct {
+	// Data is the panel's Simple XML definition.
+	Data     attributes.Explicit[string] `json:"eai:data" values:"eai:data,omitzero"`
+	Disabled attributes.Explicit[bool]   `json:"disabled" values:"disabled,omitzero" selective:"read"`
+}
+
+// Panel is a prebuilt dashboard panel.
+type Panel struct {
+	ID      client.ID    `selective:"create" service:"data/ui/panels"`
+	Content PanelContent `json:"content" values:",anonymize"`
+}
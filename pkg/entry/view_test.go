@@ -0,0 +1,147 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package entry
+
+import (
+	"encoding/json"
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+)
+
+func TestDashboardStudioData(t *testing.T) {
+	tests := []struct {
+		name           string
+		inputLabel     string
+		inputTheme     string
+		inputJSON      string
+		wantData       string
+		wantDefinition string
+		wantError      bool
+	}{
+		{
+			name:           "definition",
+			inputLabel:     "Overview",
+			inputJSON:      `{"title": "Overview", "visualizations": {}}`,
+			wantData:       `<dashboard version="2"><label>Overview</label><definition><![CDATA[{"title":"Overview","visualizations":{}}]]></definition></dashboard>`,
+			wantDefinition: `{"title":"Overview","visualizations":{}}`,
+		},
+		{
+			name:           "escaped",
+			inputLabel:     "Errors & <Warnings>",
+			inputTheme:     "dark",
+			inputJSON:      `{"title": "]]>"}`,
+			wantData:       `<dashboard version="2" theme="dark"><label>Errors &amp; &lt;Warnings&gt;</label><definition><![CDATA[{"title":"]]\u003e"}]]></definition></dashboard>`,
+			wantDefinition: `{"title":"]]\u003e"}`,
+		},
+		{
+			name:      "invalid json",
+			inputJSON: `{`,
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		gotData, err := DashboardStudioData(test.inputLabel, test.inputTheme, json.RawMessage(test.inputJSON))
+		gotError := err != nil
+
+		if gotError != test.wantError {
+			t.Errorf("%s: DashboardStudioData() returned error? %v (%s)", test.name, gotError, err)
+		}
+
+		if gotData != test.wantData {
+			t.Errorf("%s: DashboardStudioData() got\n%s, want\n%s", test.name, gotData, test.wantData)
+		}
+
+		if test.wantError {
+			continue
+		}
+
+		content := ViewContent{Data: attributes.NewExplicit(gotData)}
+		if !content.IsDashboardStudio() {
+			t.Errorf("%s: IsDashboardStudio() got false, want true", test.name)
+		}
+
+		gotDefinition, err := content.DashboardStudioDefinition()
+		if err != nil {
+			t.Errorf("%s: DashboardStudioDefinition() returned error: %s", test.name, err)
+		}
+
+		if string(gotDefinition) != test.wantDefinition {
+			t.Errorf("%s: DashboardStudioDefinition() got\n%s, want\n%s", test.name, gotDefinition, test.wantDefinition)
+		}
+	}
+}
+
+func TestViewContent_DashboardStudioDefinition_simpleXML(t *testing.T) {
+	content := ViewContent{Data: attributes.NewExplicit(`<dashboard><label>Overview</label><row></row></dashboard>`)}
+
+	if content.IsDashboardStudio() {
+		t.Errorf("IsDashboardStudio() got true, want false")
+	}
+
+	if _, err := content.DashboardStudioDefinition(); !errors.Is(err, ErrNotDashboardStudio) {
+		t.Errorf("DashboardStudioDefinition() got error %v, want %v", err, ErrNotDashboardStudio)
+	}
+}
+
+func TestViews_values(t *testing.T) {
+	view := View{
+		ID: client.ID{Title: "overview"},
+		Content: ViewContent{
+			Data:     attributes.NewExplicit("<dashboard/>"),
+			Disabled: attributes.NewExplicit(false),
+			Label:    attributes.NewExplicit("Overview"),
+		},
+	}
+
+	nav := Nav{
+		ID: client.ID{Title: "default"},
+		Content: NavContent{
+			Data: attributes.NewExplicit(`<nav><view name="overview" default="true"/></nav>`),
+		},
+	}
+
+	tests := checks.QueryValuesTestCases{
+		{
+			Name:  "view create",
+			Input: checks.MustSelective(t, view, "create"),
+			Want: url.Values{
+				"name":     []string{"overview"},
+				"eai:data": []string{"<dashboard/>"},
+			},
+		},
+		{
+			Name:  "view update",
+			Input: checks.MustSelective(t, view, "update"),
+			Want: url.Values{
+				"eai:data": []string{"<dashboard/>"},
+			},
+		},
+		{
+			Name:  "nav update",
+			Input: checks.MustSelective(t, nav, "update"),
+			Want: url.Values{
+				"eai:data": []string{`<nav><view name="overview" default="true"/></nav>`},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
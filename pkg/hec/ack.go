@@ -0,0 +1,159 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hec
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// ackTracker tracks the ack IDs of sent batches that have not yet been acknowledged.
+type ackTracker struct {
+	mu      sync.Mutex
+	pending map[int]bool
+}
+
+// add records an ack ID as pending.
+func (tracker *ackTracker) add(id int) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	tracker.pending[id] = true
+}
+
+// ids returns the pending ack IDs, in ascending order.
+func (tracker *ackTracker) ids() []int {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	ids := make([]int, 0, len(tracker.pending))
+	for id := range tracker.pending {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	return ids
+}
+
+// acknowledge removes the given ack IDs from pending.
+func (tracker *ackTracker) acknowledge(ids []int) {
+	tracker.mu.Lock()
+	defer tracker.mu.Unlock()
+
+	for _, id := range ids {
+		delete(tracker.pending, id)
+	}
+}
+
+// ackService is the collector/ack service.
+type ackService struct {
+	_ client.Namespace `service:"collector/ack"`
+}
+
+// ackRequest is the request sent to the ack endpoint.
+type ackRequest struct {
+	Acks []int `json:"acks"`
+}
+
+// ackResponse is the response returned by the ack endpoint.
+type ackResponse struct {
+	Acks map[string]bool `json:"acks"`
+}
+
+// PendingAcks returns the number of sent batches that have not yet been acknowledged.
+func (s *Sender) PendingAcks() int {
+	s.init()
+
+	return len(s.acks.ids())
+}
+
+// pollAcks checks the acknowledgement status of all pending ack IDs once.
+func (s *Sender) pollAcks(ctx context.Context) error {
+	ids := s.acks.ids()
+	if len(ids) == 0 {
+		return nil
+	}
+
+	body, err := json.Marshal(ackRequest{Acks: ids})
+	if err != nil {
+		return err
+	}
+
+	var response ackResponse
+
+	if err := s.Client.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestServiceURL(s.Client, ackService{}),
+			s.buildRequestChannel(),
+			client.BuildRequestBody(body),
+			client.BuildRequestIdempotent(),
+			client.BuildRequestDryRunExempt(),
+			client.BuildRequestAuthenticate(s.Client),
+		),
+		client.ComposeResponseHandler(
+			handleResponseError(),
+			client.HandleResponseJSON(&response),
+		),
+	); err != nil {
+		return err
+	}
+
+	var acknowledged []int
+	for idString, ok := range response.Acks {
+		id, err := strconv.Atoi(idString)
+		if err != nil || !ok {
+			continue
+		}
+
+		acknowledged = append(acknowledged, id)
+	}
+	s.acks.acknowledge(acknowledged)
+
+	return nil
+}
+
+// waitForAcks polls the acknowledgement status of pending ack IDs until all have been acknowledged.
+func (s *Sender) waitForAcks(ctx context.Context) error {
+	if !s.UseAck {
+		return nil
+	}
+
+	for {
+		if err := s.pollAcks(ctx); err != nil {
+			return err
+		}
+
+		if len(s.acks.ids()) == 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(s.AckPollInterval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
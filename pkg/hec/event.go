@@ -0,0 +1,67 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package hec implements sending data to the Splunk HTTP Event Collector.
+package hec
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event is a single event sent to the HTTP Event Collector's event endpoint.
+type Event struct {
+	// Time is the event's timestamp. If zero, the time the event is received is used.
+	Time time.Time
+
+	Host       string
+	Source     string
+	SourceType string
+	Index      string
+
+	// Event is the event data. It may be a string, or any value that can be encoded as JSON.
+	Event interface{}
+
+	// Fields are indexed fields to add to the event.
+	Fields map[string]interface{}
+}
+
+// MarshalJSON implements custom JSON marshaling.
+func (event Event) MarshalJSON() ([]byte, error) {
+	type eventJSON struct {
+		Time       *float64               `json:"time,omitempty"`
+		Host       string                 `json:"host,omitempty"`
+		Source     string                 `json:"source,omitempty"`
+		SourceType string                 `json:"sourcetype,omitempty"`
+		Index      string                 `json:"index,omitempty"`
+		Event      interface{}            `json:"event"`
+		Fields     map[string]interface{} `json:"fields,omitempty"`
+	}
+
+	encoded := eventJSON{
+		Host:       event.Host,
+		Source:     event.Source,
+		SourceType: event.SourceType,
+		Index:      event.Index,
+		Event:      event.Event,
+		Fields:     event.Fields,
+	}
+
+	if !event.Time.IsZero() {
+		epoch := float64(event.Time.UnixNano()/int64(time.Millisecond)) / 1000
+		encoded.Time = &epoch
+	}
+
+	return json.Marshal(encoded)
+}
@@ -0,0 +1,60 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hec
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestEvent_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name  string
+		input Event
+		want  string
+	}{
+		{
+			name:  "event only",
+			input: Event{Event: "hello"},
+			want:  `{"event":"hello"}`,
+		},
+		{
+			name: "all fields",
+			input: Event{
+				Time:       time.Unix(1600000000, 123456789),
+				Host:       "host",
+				Source:     "source",
+				SourceType: "sourcetype",
+				Index:      "main",
+				Event:      map[string]interface{}{"message": "hello"},
+				Fields:     map[string]interface{}{"env": "test"},
+			},
+			want: `{"time":1600000000.123,"host":"host","source":"source","sourcetype":"sourcetype","index":"main","event":{"message":"hello"},"fields":{"env":"test"}}`,
+		},
+	}
+
+	for _, test := range tests {
+		got, err := json.Marshal(test.input)
+		if err != nil {
+			t.Errorf("%s: json.Marshal returned error: %s", test.name, err)
+			continue
+		}
+
+		if string(got) != test.want {
+			t.Errorf("%s: json.Marshal got\n%s, want\n%s", test.name, got, test.want)
+		}
+	}
+}
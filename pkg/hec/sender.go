@@ -0,0 +1,459 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hec
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+const (
+	defaultMaxBatchCount   = 100
+	defaultMaxBatchBytes   = 1024 * 1024
+	defaultFlushInterval   = time.Second
+	defaultQueueSize       = 1000
+	defaultAckPollInterval = time.Second
+)
+
+var (
+	// ErrClosed is returned when attempting to use a Sender that has been closed.
+	ErrClosed = errors.New("hec: sender is closed")
+
+	// ErrWrongEndpoint is returned by Send for a raw Sender, and by SendRaw for an event Sender.
+	ErrWrongEndpoint = errors.New("hec: data type doesn't match Sender's endpoint")
+)
+
+// Error is an error response returned by the HTTP Event Collector.
+type Error struct {
+	StatusCode int
+	Code       int    `json:"code"`
+	Text       string `json:"text"`
+}
+
+// Error implements the error interface.
+func (err Error) Error() string {
+	return fmt.Sprintf("hec: %s (code %d, status %d)", err.Text, err.Code, err.StatusCode)
+}
+
+// RawOptions configures a Sender to send data to the raw endpoint, with the given metadata applied
+// to all of its events.
+type RawOptions struct {
+	Host       string `values:"host,omitzero"`
+	Source     string `values:"source,omitzero"`
+	SourceType string `values:"sourcetype,omitzero"`
+	Index      string `values:"index,omitzero"`
+}
+
+// Sender sends data to the HTTP Event Collector in batches. Data is queued by Send or SendRaw, and
+// sent when a batch reaches MaxBatchCount or MaxBatchBytes. Any partial batch is also sent every
+// FlushInterval, regardless of when the last batch was sent. When the queue is full, Send and SendRaw
+// block until there is room, providing backpressure to callers.
+//
+// Its Client's URL must be that of the HTTP Event Collector, such as https://localhost:8088, and its
+// Authenticator should be authenticators.HECToken.
+//
+// A Sender's fields must not be changed after the first call to Send or SendRaw, and it must be
+// closed with Close to send any remaining queued data.
+type Sender struct {
+	Client *client.Client
+
+	// Raw sends data to the raw endpoint if set, otherwise data is sent to the event endpoint.
+	Raw *RawOptions
+
+	// Channel is the channel identifier sent with each request. If UseAck is true and Channel is
+	// empty, a random channel identifier is generated.
+	Channel string
+
+	// UseAck enables indexer acknowledgement. Flush and Close wait for sent batches to be acknowledged.
+	UseAck bool
+
+	// Gzip enables gzip compression of requests.
+	Gzip bool
+
+	// MaxBatchCount is the maximum number of events sent in a single request. If unspecified,
+	// defaults to 100.
+	MaxBatchCount int
+
+	// MaxBatchBytes is the maximum size of a single request before compression. If unspecified,
+	// defaults to 1 MiB. Larger events are sent in a request of their own.
+	MaxBatchBytes int
+
+	// FlushInterval is the maximum amount of time queued data waits to be sent. If unspecified,
+	// defaults to 1 second.
+	FlushInterval time.Duration
+
+	// QueueSize is the number of events that can be queued before Send and SendRaw block. If
+	// unspecified, defaults to 1000.
+	QueueSize int
+
+	// AckPollInterval is the interval between indexer acknowledgement status checks. If unspecified,
+	// defaults to 1 second.
+	AckPollInterval time.Duration
+
+	// ErrorHandler, if set, is called with errors encountered while sending batches in the background.
+	ErrorHandler func(error)
+
+	once     sync.Once
+	queue    chan senderRequest
+	done     chan struct{}
+	mu       sync.RWMutex
+	closed   bool
+	errMu    sync.Mutex
+	firstErr error
+	acks     *ackTracker
+}
+
+// senderRequest is a request to the Sender's background goroutine to queue a payload, or to flush
+// the current batch if flushed is set.
+type senderRequest struct {
+	payload []byte
+	flushed chan error
+}
+
+// init prepares the Sender for use, and starts its background goroutine.
+func (s *Sender) init() {
+	s.once.Do(func() {
+		if s.MaxBatchCount <= 0 {
+			s.MaxBatchCount = defaultMaxBatchCount
+		}
+
+		if s.MaxBatchBytes <= 0 {
+			s.MaxBatchBytes = defaultMaxBatchBytes
+		}
+
+		if s.FlushInterval <= 0 {
+			s.FlushInterval = defaultFlushInterval
+		}
+
+		if s.QueueSize <= 0 {
+			s.QueueSize = defaultQueueSize
+		}
+
+		if s.AckPollInterval <= 0 {
+			s.AckPollInterval = defaultAckPollInterval
+		}
+
+		if s.UseAck && s.Channel == "" {
+			s.Channel = newChannelID()
+		}
+
+		s.acks = &ackTracker{pending: map[int]bool{}}
+		s.queue = make(chan senderRequest, s.QueueSize)
+		s.done = make(chan struct{})
+
+		go s.run()
+	})
+}
+
+// newChannelID returns a random UUID to use as a channel identifier.
+func newChannelID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// enqueue adds a request to the Sender's queue, blocking until there is room or ctx is done.
+func (s *Sender) enqueue(ctx context.Context, request senderRequest) error {
+	s.init()
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if s.closed {
+		return ErrClosed
+	}
+
+	select {
+	case s.queue <- request:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Send queues an Event to be sent to the event endpoint.
+func (s *Sender) Send(ctx context.Context, event Event) error {
+	if s.Raw != nil {
+		return ErrWrongEndpoint
+	}
+
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("hec: unable to encode event: %w", err)
+	}
+
+	return s.enqueue(ctx, senderRequest{payload: payload})
+}
+
+// SendRaw queues raw data to be sent to the raw endpoint. A newline is appended to data if it
+// doesn't already end with one, so that batched data is split into separate events.
+func (s *Sender) SendRaw(ctx context.Context, data []byte) error {
+	if s.Raw == nil {
+		return ErrWrongEndpoint
+	}
+
+	payload := make([]byte, len(data), len(data)+1)
+	copy(payload, data)
+	if len(payload) == 0 || payload[len(payload)-1] != '\n' {
+		payload = append(payload, '\n')
+	}
+
+	return s.enqueue(ctx, senderRequest{payload: payload})
+}
+
+// Flush sends any queued data, and waits for it to be acknowledged if UseAck is true.
+func (s *Sender) Flush(ctx context.Context) error {
+	flushed := make(chan error, 1)
+	if err := s.enqueue(ctx, senderRequest{flushed: flushed}); err != nil {
+		return err
+	}
+
+	select {
+	case err := <-flushed:
+		if err != nil {
+			return err
+		}
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	return s.waitForAcks(ctx)
+}
+
+// Close sends any queued data and stops the Sender, waiting for data to be acknowledged if UseAck is
+// true. It returns the first error encountered while sending batches, if any. Subsequent calls to
+// Send, SendRaw, Flush or Close return ErrClosed.
+func (s *Sender) Close(ctx context.Context) error {
+	s.init()
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return ErrClosed
+	}
+	s.closed = true
+	close(s.queue)
+	s.mu.Unlock()
+
+	select {
+	case <-s.done:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	if err := s.waitForAcks(ctx); err != nil {
+		return err
+	}
+
+	s.errMu.Lock()
+	defer s.errMu.Unlock()
+
+	return s.firstErr
+}
+
+// handleError records an error encountered while sending batches in the background.
+func (s *Sender) handleError(err error) {
+	s.errMu.Lock()
+	if s.firstErr == nil {
+		s.firstErr = err
+	}
+	s.errMu.Unlock()
+
+	if s.ErrorHandler != nil {
+		s.ErrorHandler(err)
+	}
+}
+
+// run is the Sender's background goroutine, which batches queued data and sends it.
+func (s *Sender) run() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(s.FlushInterval)
+	defer ticker.Stop()
+
+	var batch bytes.Buffer
+	count := 0
+
+	flush := func() error {
+		if count == 0 {
+			return nil
+		}
+
+		err := s.post(context.Background(), batch.Bytes())
+		batch.Reset()
+		count = 0
+
+		if err != nil {
+			s.handleError(err)
+		}
+
+		return err
+	}
+
+	for {
+		select {
+		case request, ok := <-s.queue:
+			if !ok {
+				_ = flush()
+				return
+			}
+
+			if request.flushed != nil {
+				request.flushed <- flush()
+				continue
+			}
+
+			if count > 0 && batch.Len()+len(request.payload) > s.MaxBatchBytes {
+				_ = flush()
+			}
+
+			batch.Write(request.payload)
+			count++
+
+			if count >= s.MaxBatchCount || batch.Len() >= s.MaxBatchBytes {
+				_ = flush()
+			}
+		case <-ticker.C:
+			_ = flush()
+
+			if s.UseAck {
+				if err := s.pollAcks(context.Background()); err != nil {
+					s.handleError(err)
+				}
+			}
+		}
+	}
+}
+
+// eventService is the collector/event service.
+type eventService struct {
+	_ client.Namespace `service:"collector/event"`
+}
+
+// rawService is the collector/raw service.
+type rawService struct {
+	_ client.Namespace `service:"collector/raw"`
+}
+
+// postResponse is the response returned by the event and raw endpoints.
+type postResponse struct {
+	Text  string `json:"text"`
+	Code  int    `json:"code"`
+	AckID *int   `json:"ackId"`
+}
+
+// buildRequestBody returns a RequestBuilder that sets the request's Body to data, compressing
+// it if Gzip is true.
+func (s *Sender) buildRequestBody(data []byte) client.RequestBuilder {
+	return func(r *http.Request) error {
+		if !s.Gzip {
+			return client.BuildRequestBody(data)(r)
+		}
+
+		var compressed bytes.Buffer
+		writer := gzip.NewWriter(&compressed)
+		if _, err := writer.Write(data); err != nil {
+			return fmt.Errorf("hec: unable to compress request: %w", err)
+		}
+		if err := writer.Close(); err != nil {
+			return fmt.Errorf("hec: unable to compress request: %w", err)
+		}
+
+		return client.ComposeRequestBuilder(
+			client.BuildRequestHeader("Content-Encoding", "gzip"),
+			client.BuildRequestBody(compressed.Bytes()),
+		)(r)
+	}
+}
+
+// buildRequestChannel returns a RequestBuilder that sets the request's channel, if one is configured.
+func (s *Sender) buildRequestChannel() client.RequestBuilder {
+	return func(r *http.Request) error {
+		if s.Channel == "" {
+			return nil
+		}
+
+		return client.BuildRequestHeader("X-Splunk-Request-Channel", s.Channel)(r)
+	}
+}
+
+// handleResponseError returns a ResponseHandler that returns an Error decoded from a non-200 response.
+func handleResponseError() client.ResponseHandler {
+	return func(r *http.Response) error {
+		if r.StatusCode == http.StatusOK {
+			return nil
+		}
+
+		hecErr := Error{}
+		_ = json.NewDecoder(r.Body).Decode(&hecErr)
+		hecErr.StatusCode = r.StatusCode
+
+		if hecErr.Text == "" {
+			hecErr.Text = r.Status
+		}
+
+		return hecErr
+	}
+}
+
+// post sends a batch of data to the Sender's endpoint.
+func (s *Sender) post(ctx context.Context, data []byte) error {
+	var service interface{} = eventService{}
+	var query interface{} = struct{}{}
+	if s.Raw != nil {
+		service = rawService{}
+		query = *s.Raw
+	}
+
+	var response postResponse
+
+	if err := s.Client.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestServiceURL(s.Client, service),
+			client.BuildRequestQueryValues(query),
+			s.buildRequestChannel(),
+			s.buildRequestBody(data),
+			client.BuildRequestAuthenticate(s.Client),
+		),
+		client.ComposeResponseHandler(
+			handleResponseError(),
+			client.HandleResponseJSON(&response),
+		),
+	); err != nil {
+		return err
+	}
+
+	if s.UseAck && response.AckID != nil {
+		s.acks.add(*response.AckID)
+	}
+
+	return nil
+}
@@ -0,0 +1,285 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hec
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/splunk/go-splunk-client/pkg/authenticators"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// testHECRequest is a request received by testHECServer.
+type testHECRequest struct {
+	Path    string
+	Query   string
+	Channel string
+	Body    string
+}
+
+// testHECServer simulates the HTTP Event Collector, acknowledging each batch on the second ack poll.
+type testHECServer struct {
+	mu       sync.Mutex
+	requests []testHECRequest
+	nextAck  int
+	polls    map[int]int
+	status   int
+}
+
+func (s *testHECServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if r.Header.Get("Authorization") != "Splunk test-token" {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"text":"Token is required","code":2}`)
+		return
+	}
+
+	if s.status != 0 {
+		w.WriteHeader(s.status)
+		fmt.Fprint(w, `{"text":"Incorrect index","code":7}`)
+		return
+	}
+
+	var body io.Reader = r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		body, _ = gzip.NewReader(r.Body)
+	}
+	data, _ := io.ReadAll(body)
+
+	if r.URL.Path == "/services/collector/ack" {
+		request := ackRequest{}
+		_ = json.Unmarshal(data, &request)
+
+		response := ackResponse{Acks: map[string]bool{}}
+		for _, id := range request.Acks {
+			s.polls[id]++
+			response.Acks[fmt.Sprint(id)] = s.polls[id] > 1
+		}
+
+		_ = json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	s.requests = append(s.requests, testHECRequest{
+		Path:    r.URL.Path,
+		Query:   r.URL.RawQuery,
+		Channel: r.Header.Get("X-Splunk-Request-Channel"),
+		Body:    string(data),
+	})
+
+	fmt.Fprintf(w, `{"text":"Success","code":0,"ackId":%d}`, s.nextAck)
+	s.nextAck++
+}
+
+func TestSender(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputSender  *Sender
+		inputStatus  int
+		inputSend    func(context.Context, *Sender) error
+		wantRequests []testHECRequest
+		wantError    bool
+	}{
+		{
+			name:        "batched by count",
+			inputSender: &Sender{MaxBatchCount: 2, FlushInterval: time.Hour},
+			inputSend: func(ctx context.Context, s *Sender) error {
+				for i := 0; i < 3; i++ {
+					if err := s.Send(ctx, Event{Event: i}); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantRequests: []testHECRequest{
+				{Path: "/services/collector/event", Body: `{"event":0}{"event":1}`},
+				{Path: "/services/collector/event", Body: `{"event":2}`},
+			},
+		},
+		{
+			name:        "batched by bytes",
+			inputSender: &Sender{MaxBatchBytes: 15, FlushInterval: time.Hour},
+			inputSend: func(ctx context.Context, s *Sender) error {
+				for i := 0; i < 3; i++ {
+					if err := s.Send(ctx, Event{Event: i}); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+			wantRequests: []testHECRequest{
+				{Path: "/services/collector/event", Body: `{"event":0}`},
+				{Path: "/services/collector/event", Body: `{"event":1}`},
+				{Path: "/services/collector/event", Body: `{"event":2}`},
+			},
+		},
+		{
+			name:        "raw gzip",
+			inputSender: &Sender{Raw: &RawOptions{SourceType: "syslog", Index: "main"}, Gzip: true, Channel: "test-channel"},
+			inputSend: func(ctx context.Context, s *Sender) error {
+				if err := s.SendRaw(ctx, []byte("line 1")); err != nil {
+					return err
+				}
+				return s.SendRaw(ctx, []byte("line 2\n"))
+			},
+			wantRequests: []testHECRequest{
+				{Path: "/services/collector/raw", Query: "index=main&sourcetype=syslog", Channel: "test-channel", Body: "line 1\nline 2\n"},
+			},
+		},
+		{
+			name:        "wrong endpoint",
+			inputSender: &Sender{Raw: &RawOptions{}},
+			inputSend: func(ctx context.Context, s *Sender) error {
+				return s.Send(ctx, Event{Event: "event"})
+			},
+			wantError: true,
+		},
+		{
+			name:        "error response",
+			inputSender: &Sender{},
+			inputStatus: http.StatusBadRequest,
+			inputSend: func(ctx context.Context, s *Sender) error {
+				return s.Send(ctx, Event{Event: "event"})
+			},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		server := httptest.NewServer(&testHECServer{polls: map[int]int{}, status: test.inputStatus})
+		hecServer := server.Config.Handler.(*testHECServer)
+
+		sender := test.inputSender
+		sender.Client = &client.Client{URL: server.URL, Authenticator: authenticators.HECToken{Token: "test-token"}}
+
+		ctx := context.Background()
+		err := test.inputSend(ctx, sender)
+		if closeErr := sender.Close(ctx); err == nil {
+			err = closeErr
+		}
+		server.Close()
+
+		gotError := err != nil
+		if gotError != test.wantError {
+			t.Errorf("%s: returned error? %v (%s)", test.name, gotError, err)
+		}
+
+		if !reflect.DeepEqual(hecServer.requests, test.wantRequests) {
+			t.Errorf("%s: got requests\n%#v, want\n%#v", test.name, hecServer.requests, test.wantRequests)
+		}
+	}
+}
+
+func TestSender_ack(t *testing.T) {
+	hecServer := &testHECServer{polls: map[int]int{}}
+	server := httptest.NewServer(hecServer)
+	defer server.Close()
+
+	sender := &Sender{
+		Client:          &client.Client{URL: server.URL, Authenticator: authenticators.HECToken{Token: "test-token"}},
+		UseAck:          true,
+		AckPollInterval: time.Millisecond,
+		FlushInterval:   time.Hour,
+	}
+
+	ctx := context.Background()
+	if err := sender.Send(ctx, Event{Event: "event"}); err != nil {
+		t.Fatalf("Send() returned error: %s", err)
+	}
+
+	if err := sender.Flush(ctx); err != nil {
+		t.Fatalf("Flush() returned error: %s", err)
+	}
+
+	if got := sender.PendingAcks(); got != 0 {
+		t.Errorf("Flush() left %d pending acks, want 0", got)
+	}
+
+	if got := hecServer.polls[0]; got != 2 {
+		t.Errorf("Flush() polled ack 0 %d times, want 2", got)
+	}
+
+	if len(hecServer.requests) != 1 || hecServer.requests[0].Channel == "" {
+		t.Errorf("Flush() got requests %#v, want 1 with generated channel", hecServer.requests)
+	}
+
+	if err := sender.Close(ctx); err != nil {
+		t.Errorf("Close() returned error: %s", err)
+	}
+
+	if err := sender.Send(ctx, Event{Event: "event"}); !errors.Is(err, ErrClosed) {
+		t.Errorf("Send() after Close() got error %v, want %v", err, ErrClosed)
+	}
+}
+
+func TestSender_backpressure(t *testing.T) {
+	blocked := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-blocked
+		fmt.Fprint(w, `{"text":"Success","code":0}`)
+	}))
+	defer server.Close()
+
+	sender := &Sender{
+		Client:        &client.Client{URL: server.URL, Authenticator: authenticators.HECToken{Token: "test-token"}},
+		MaxBatchCount: 1,
+		QueueSize:     1,
+	}
+
+	ctx := context.Background()
+
+	// the first event is taken by the background goroutine, which blocks while sending it, and
+	// the second fills the queue
+	for i := 0; i < 2; i++ {
+		if err := sender.Send(ctx, Event{Event: i}); err != nil {
+			t.Fatalf("Send() returned error: %s", err)
+		}
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+
+	if err := sender.Send(timeoutCtx, Event{Event: 2}); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Send() to full queue got error %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	close(blocked)
+
+	if err := sender.Close(ctx); err != nil {
+		t.Errorf("Close() returned error: %s", err)
+	}
+}
+
+func TestNewChannelID(t *testing.T) {
+	got := newChannelID()
+
+	if parts := strings.Split(got, "-"); len(parts) != 5 || len(got) != 36 {
+		t.Errorf("newChannelID() got %q, want UUID", got)
+	}
+}
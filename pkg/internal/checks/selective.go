@@ -0,0 +1,32 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks
+
+import (
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/selective"
+)
+
+// MustSelective returns the value of i selected for tag by selective.Encode, failing the test if
+// it returns an error.
+func MustSelective(t *testing.T, i interface{}, tag string) interface{} {
+	selected, err := selective.Encode(i, tag)
+	if err != nil {
+		t.Fatalf("selective.Encode returned error: %s", err)
+	}
+
+	return selected
+}
@@ -0,0 +1,36 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checks
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// NewCheckRequestServer returns an httptest.Server that performs check against each request it
+// receives, then responds with statusCode and body. A statusCode of 0 responds with http.StatusOK.
+func NewCheckRequestServer(t *testing.T, check CheckRequestFunc, statusCode int, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		check(r, t)
+
+		if statusCode != 0 {
+			w.WriteHeader(statusCode)
+		}
+
+		_, _ = io.WriteString(w, body)
+	}))
+}
@@ -0,0 +1,194 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package kvstore implements managing the documents stored in KV store collections with the Splunk REST API.
+//
+// Collections themselves are managed as entry.KVStoreCollection entries. Documents are encoded to and
+// decoded from JSON, so any type that can be used with encoding/json can be stored as a document.
+package kvstore
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/entry"
+)
+
+// collectionData is the data endpoint of a KV store collection, beneath which its documents exist.
+type collectionData struct {
+	ID client.ID `service:"storage/collections/data"`
+}
+
+// dataForCollection returns the collectionData for the given KVStoreCollection.
+func dataForCollection(collection entry.KVStoreCollection) collectionData {
+	return collectionData{ID: client.ID{Namespace: collection.ID.Namespace, Title: collection.ID.Title}}
+}
+
+// keyResponse is the response returned when a single document is written.
+type keyResponse struct {
+	Key string `json:"_key"`
+}
+
+// QueryOptions define which documents are returned by Query.
+type QueryOptions struct {
+	// Query is a JSON-encoded query object, such as `{"status": "active"}`.
+	Query string `values:"query,omitzero"`
+
+	// Fields limits the returned fields to those listed. Fields may be suffixed with ":0" to exclude
+	// them instead.
+	Fields []string `values:"-"`
+
+	// Sort is a comma-separated list of fields to sort by. Fields may be suffixed with ":1" or ":-1"
+	// to sort them in ascending or descending order.
+	Sort string `values:"sort,omitzero"`
+
+	// Skip is the number of documents to skip.
+	Skip int `values:"skip,omitzero"`
+
+	// Limit is the maximum number of documents to return. The zero value returns all documents,
+	// up to the server's configured limit.
+	Limit int `values:"limit,omitzero"`
+}
+
+// queryRequest is the query sent for a Query.
+type queryRequest struct {
+	QueryOptions `values:",anonymize"`
+
+	Fields string `values:"fields,omitzero"`
+}
+
+// Insert adds document to the given collection, returning its key. If document doesn't have a "_key"
+// field, the key is generated by Splunk.
+func Insert(ctx context.Context, c *client.Client, collection entry.KVStoreCollection, document interface{}) (string, error) {
+	var response keyResponse
+
+	err := c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestEntryURL(c, dataForCollection(collection)),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestBodyJSON(document),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseCode(http.StatusNotFound, client.HandleResponseJSONMessagesCustomError(client.ErrorNotFound)),
+			client.HandleResponseRequireCode(http.StatusCreated, client.HandleResponseJSONMessagesError()),
+			client.HandleResponseJSON(&response),
+		),
+	)
+
+	return response.Key, err
+}
+
+// BatchSave inserts or updates each document in documents, which must encode to a JSON array,
+// returning their keys in the same order. Documents with a "_key" field matching an existing
+// document replace it.
+func BatchSave(ctx context.Context, c *client.Client, collection entry.KVStoreCollection, documents interface{}) ([]string, error) {
+	var keys []string
+
+	err := c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestEntryActionURL(c, dataForCollection(collection), "batch_save"),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestBodyJSON(documents),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseCode(http.StatusNotFound, client.HandleResponseJSONMessagesCustomError(client.ErrorNotFound)),
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+			client.HandleResponseJSON(&keys),
+		),
+	)
+
+	return keys, err
+}
+
+// Get decodes the document with the given key into dest, which must be a pointer. An error matching
+// client.ErrNotFound is returned if the document doesn't exist.
+func Get(ctx context.Context, c *client.Client, collection entry.KVStoreCollection, key string, dest interface{}) error {
+	return c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodGet),
+			client.BuildRequestEntryActionURL(c, dataForCollection(collection), url.PathEscape(key)),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseCode(http.StatusNotFound, client.HandleResponseJSONMessagesCustomError(client.ErrorNotFound)),
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+			client.HandleResponseJSON(dest),
+		),
+	)
+}
+
+// Update replaces the document with the given key with document.
+func Update(ctx context.Context, c *client.Client, collection entry.KVStoreCollection, key string, document interface{}) error {
+	return c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestEntryActionURL(c, dataForCollection(collection), url.PathEscape(key)),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestBodyJSON(document),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseCode(http.StatusNotFound, client.HandleResponseJSONMessagesCustomError(client.ErrorNotFound)),
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+		),
+	)
+}
+
+// Delete removes the document with the given key.
+func Delete(ctx context.Context, c *client.Client, collection entry.KVStoreCollection, key string) error {
+	return c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodDelete),
+			client.BuildRequestEntryActionURL(c, dataForCollection(collection), url.PathEscape(key)),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseCode(http.StatusNotFound, client.HandleResponseJSONMessagesCustomError(client.ErrorNotFound)),
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+		),
+	)
+}
+
+// Query decodes the documents matching options into dest, which must be a pointer to a slice.
+func Query(ctx context.Context, c *client.Client, collection entry.KVStoreCollection, options QueryOptions, dest interface{}) error {
+	return c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodGet),
+			client.BuildRequestEntryURL(c, dataForCollection(collection)),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestQueryValues(queryRequest{QueryOptions: options, Fields: strings.Join(options.Fields, ",")}),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseCode(http.StatusNotFound, client.HandleResponseJSONMessagesCustomError(client.ErrorNotFound)),
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+			client.HandleResponseJSON(dest),
+		),
+	)
+}
@@ -0,0 +1,191 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package kvstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/authenticators"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/entry"
+)
+
+// testDocument is a document stored in a testKVStoreServer.
+type testDocument struct {
+	Key    string `json:"_key,omitempty"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// testKVStoreServer is an http.Handler that stores documents for a single collection in memory.
+type testKVStoreServer struct {
+	documents map[string]testDocument
+	nextKey   int
+	gotQuery  url.Values
+}
+
+func (server *testKVStoreServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	const collectionPath = "/servicesNS/nobody/search/storage/collections/data/test"
+
+	if !strings.HasPrefix(r.URL.EscapedPath(), collectionPath) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"messages":[{"type":"ERROR","text":"Collection not found"}]}`)
+		return
+	}
+
+	key, _ := url.PathUnescape(strings.TrimPrefix(strings.TrimPrefix(r.URL.EscapedPath(), collectionPath), "/"))
+
+	save := func(document testDocument) string {
+		if document.Key == "" {
+			server.nextKey++
+			document.Key = fmt.Sprintf("key%d", server.nextKey)
+		}
+		server.documents[document.Key] = document
+
+		return document.Key
+	}
+
+	switch {
+	case r.Method == http.MethodPost && key == "":
+		var document testDocument
+		json.NewDecoder(r.Body).Decode(&document)
+
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(map[string]string{"_key": save(document)})
+	case r.Method == http.MethodPost && key == "batch_save":
+		var documents []testDocument
+		json.NewDecoder(r.Body).Decode(&documents)
+
+		keys := []string{}
+		for _, document := range documents {
+			keys = append(keys, save(document))
+		}
+		json.NewEncoder(w).Encode(keys)
+	case r.Method == http.MethodGet && key == "":
+		server.gotQuery = r.URL.Query()
+
+		keys := []string{}
+		for key := range server.documents {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		documents := []testDocument{}
+		for _, key := range keys {
+			documents = append(documents, server.documents[key])
+		}
+		json.NewEncoder(w).Encode(documents)
+	default:
+		document, ok := server.documents[key]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"messages":[{"type":"ERROR","text":"Could not find object."}]}`)
+			return
+		}
+
+		switch r.Method {
+		case http.MethodGet:
+			json.NewEncoder(w).Encode(document)
+		case http.MethodPost:
+			var newDocument testDocument
+			json.NewDecoder(r.Body).Decode(&newDocument)
+			newDocument.Key = key
+			save(newDocument)
+			json.NewEncoder(w).Encode(map[string]string{"_key": key})
+		case http.MethodDelete:
+			delete(server.documents, key)
+		}
+	}
+}
+
+func TestKVStore(t *testing.T) {
+	store := &testKVStoreServer{documents: map[string]testDocument{}}
+	server := httptest.NewServer(store)
+	defer server.Close()
+
+	ctx := context.Background()
+	c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+	collection := entry.KVStoreCollection{ID: client.ID{Namespace: client.Namespace{User: "nobody", App: "search"}, Title: "test"}}
+
+	key, err := Insert(ctx, c, collection, testDocument{Name: "first", Status: "active"})
+	if err != nil {
+		t.Fatalf("Insert() returned error: %s", err)
+	}
+	if key != "key1" {
+		t.Errorf("Insert() got key %q, want %q", key, "key1")
+	}
+
+	keys, err := BatchSave(ctx, c, collection, []testDocument{{Key: "a/b", Name: "second"}, {Name: "third"}})
+	if err != nil {
+		t.Fatalf("BatchSave() returned error: %s", err)
+	}
+	if wantKeys := []string{"a/b", "key2"}; !reflect.DeepEqual(keys, wantKeys) {
+		t.Errorf("BatchSave() got keys %#v, want %#v", keys, wantKeys)
+	}
+
+	if err := Update(ctx, c, collection, "a/b", testDocument{Name: "second", Status: "inactive"}); err != nil {
+		t.Fatalf("Update() returned error: %s", err)
+	}
+
+	var got testDocument
+	if err := Get(ctx, c, collection, "a/b", &got); err != nil {
+		t.Fatalf("Get() returned error: %s", err)
+	}
+	if want := (testDocument{Key: "a/b", Name: "second", Status: "inactive"}); got != want {
+		t.Errorf("Get() got\n%#v, want\n%#v", got, want)
+	}
+
+	if err := Delete(ctx, c, collection, "key2"); err != nil {
+		t.Fatalf("Delete() returned error: %s", err)
+	}
+
+	var gotDocuments []testDocument
+	if err := Query(ctx, c, collection, QueryOptions{Query: `{"status":"active"}`, Fields: []string{"name", "status"}, Sort: "name:1", Limit: 10}, &gotDocuments); err != nil {
+		t.Fatalf("Query() returned error: %s", err)
+	}
+
+	wantDocuments := []testDocument{
+		{Key: "a/b", Name: "second", Status: "inactive"},
+		{Key: "key1", Name: "first", Status: "active"},
+	}
+	if !reflect.DeepEqual(gotDocuments, wantDocuments) {
+		t.Errorf("Query() got\n%#v, want\n%#v", gotDocuments, wantDocuments)
+	}
+
+	wantQuery := url.Values{
+		"output_mode": []string{"json"},
+		"query":       []string{`{"status":"active"}`},
+		"fields":      []string{"name,status"},
+		"sort":        []string{"name:1"},
+		"limit":       []string{"10"},
+	}
+	if !reflect.DeepEqual(store.gotQuery, wantQuery) {
+		t.Errorf("Query() got query\n%#v, want\n%#v", store.gotQuery, wantQuery)
+	}
+
+	if err := Get(ctx, c, collection, "key2", &got); !errors.Is(err, client.ErrNotFound) {
+		t.Errorf("Get() for deleted document got error %v, want %v", err, client.ErrNotFound)
+	}
+}
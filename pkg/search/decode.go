@@ -0,0 +1,155 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Decode decodes the Row into dest, which must be a pointer to a struct. Struct fields are matched
+// to Row fields by their "search" tag, or by their name if they have no tag. Fields tagged with
+// "-" are ignored.
+//
+//	type HostCount struct {
+//		Host    string   `search:"host"`
+//		Count   int      `search:"count"`
+//		Sources []string `search:"source"`
+//	}
+//
+// Supported struct field types are string, bool, the int, uint and float types, and types that
+// implement encoding.TextUnmarshaler, all of which are set from the Row field's first value.
+// Struct fields of type []string are set to all of the Row field's values, to support multivalue
+// fields. Struct fields with no matching Row field are left unchanged.
+func (row Row) Decode(dest interface{}) error {
+	destV := reflect.ValueOf(dest)
+	if destV.Kind() != reflect.Ptr || destV.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("search: attempted to decode Row to non-struct pointer (%T)", dest)
+	}
+
+	return row.decodeStruct(destV.Elem())
+}
+
+// Decode decodes the Results' Rows into dest, which must be a pointer to a slice of structs.
+// Each Row is decoded as described by Row.Decode.
+func (results Results) Decode(dest interface{}) error {
+	destV := reflect.ValueOf(dest)
+	if destV.Kind() != reflect.Ptr || destV.Elem().Kind() != reflect.Slice || destV.Elem().Type().Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("search: attempted to decode Results to non-struct slice pointer (%T)", dest)
+	}
+
+	sliceV := reflect.MakeSlice(destV.Elem().Type(), len(results.Rows), len(results.Rows))
+	for i, row := range results.Rows {
+		if err := row.decodeStruct(sliceV.Index(i)); err != nil {
+			return err
+		}
+	}
+
+	destV.Elem().Set(sliceV)
+
+	return nil
+}
+
+// textUnmarshalerT is the reflect.Type of encoding.TextUnmarshaler.
+var textUnmarshalerT = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
+// decodeStruct decodes the Row into structV, which must be a settable struct value.
+func (row Row) decodeStruct(structV reflect.Value) error {
+	structT := structV.Type()
+
+	for i := 0; i < structT.NumField(); i++ {
+		field := structT.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("search"); ok {
+			name = tag
+		}
+
+		if name == "-" {
+			continue
+		}
+
+		values, ok := row[name]
+		if !ok {
+			continue
+		}
+
+		if err := decodeValues(values, structV.Field(i)); err != nil {
+			return fmt.Errorf("search: unable to decode field %s into %s: %w", name, field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// decodeValues decodes values into fieldV.
+func decodeValues(values []string, fieldV reflect.Value) error {
+	if fieldV.Kind() == reflect.Slice && fieldV.Type().Elem().Kind() == reflect.String {
+		sliceV := reflect.MakeSlice(fieldV.Type(), len(values), len(values))
+		for i, value := range values {
+			sliceV.Index(i).SetString(value)
+		}
+		fieldV.Set(sliceV)
+
+		return nil
+	}
+
+	if len(values) == 0 {
+		return nil
+	}
+	value := values[0]
+
+	if reflect.PtrTo(fieldV.Type()).Implements(textUnmarshalerT) {
+		return fieldV.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value))
+	}
+
+	switch fieldV.Kind() {
+	case reflect.String:
+		fieldV.SetString(value)
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+		fieldV.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(value, 10, fieldV.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fieldV.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(value, 10, fieldV.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fieldV.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(value, fieldV.Type().Bits())
+		if err != nil {
+			return err
+		}
+		fieldV.SetFloat(parsed)
+	default:
+		return fmt.Errorf("unsupported type %s", fieldV.Type())
+	}
+
+	return nil
+}
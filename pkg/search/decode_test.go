@@ -0,0 +1,125 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"net"
+	"reflect"
+	"testing"
+)
+
+type testDecodeRow struct {
+	Host     string   `search:"host"`
+	Count    int      `search:"count"`
+	Ratio    float64  `search:"ratio"`
+	Enabled  bool     `search:"enabled"`
+	Sources  []string `search:"source"`
+	IP       net.IP   `search:"ip"`
+	Ignored  string   `search:"-"`
+	Untagged string
+}
+
+func TestResults_Decode(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     Results
+		want      []testDecodeRow
+		wantError bool
+	}{
+		{
+			name:  "empty",
+			input: Results{},
+			want:  []testDecodeRow{},
+		},
+		{
+			name: "all types",
+			input: Results{Rows: []Row{
+				{
+					"host":     {"a"},
+					"count":    {"3"},
+					"ratio":    {"0.5"},
+					"enabled":  {"1"},
+					"source":   {"x", "y"},
+					"ip":       {"10.0.0.1"},
+					"-":        {"ignored"},
+					"Untagged": {"untagged"},
+				},
+				{
+					"host":   {"b", "c"},
+					"source": {"z"},
+				},
+			}},
+			want: []testDecodeRow{
+				{
+					Host:     "a",
+					Count:    3,
+					Ratio:    0.5,
+					Enabled:  true,
+					Sources:  []string{"x", "y"},
+					IP:       net.ParseIP("10.0.0.1"),
+					Untagged: "untagged",
+				},
+				{
+					Host:    "b",
+					Sources: []string{"z"},
+				},
+			},
+		},
+		{
+			name: "invalid int",
+			input: Results{Rows: []Row{
+				{"count": {"many"}},
+			}},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		var got []testDecodeRow
+		err := test.input.Decode(&got)
+		gotError := err != nil
+
+		if gotError != test.wantError {
+			t.Errorf("%s: Decode() returned error? %v (%s)", test.name, gotError, err)
+			continue
+		}
+
+		if !test.wantError && !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: Decode() got\n%#v, want\n%#v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestRow_Decode_invalidDest(t *testing.T) {
+	tests := []struct {
+		name  string
+		input interface{}
+	}{
+		{
+			name:  "non-pointer",
+			input: testDecodeRow{},
+		},
+		{
+			name:  "non-struct",
+			input: new(string),
+		},
+	}
+
+	for _, test := range tests {
+		if err := (Row{}).Decode(test.input); err == nil {
+			t.Errorf("%s: Decode() returned no error", test.name)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/messages"
+)
+
+// ErrExportFailed is returned by Export when the export stream contains an error message.
+var ErrExportFailed = errors.New("search: export failed")
+
+// ExportOutputMode is the format in which Export requests results to be streamed.
+type ExportOutputMode string
+
+const (
+	// ExportOutputModeJSON streams results as JSON, and is the default ExportOutputMode.
+	ExportOutputModeJSON ExportOutputMode = "json"
+
+	// ExportOutputModeCSV streams results as CSV. CSV results are never previews, and each
+	// field has a single value.
+	ExportOutputModeCSV ExportOutputMode = "csv"
+)
+
+// ExportVersion is the version of the export endpoint used by Export.
+type ExportVersion int
+
+const (
+	// ExportVersion1 uses search/jobs/export, and is the default ExportVersion.
+	ExportVersion1 ExportVersion = iota
+
+	// ExportVersion2 uses search/v2/jobs/export.
+	ExportVersion2
+)
+
+// ExportOptions defines the parameters used to Export a search.
+type ExportOptions struct {
+	SearchJobOptions `values:",anonymize"`
+
+	OutputMode ExportOutputMode `values:"-"`
+	Version    ExportVersion    `values:"-"`
+}
+
+// ExportRow is a single Row delivered by Export, along with its position in the stream.
+type ExportRow struct {
+	// Preview is true if the Row is part of a preview result set, which will be superseded by
+	// a later result set.
+	Preview bool
+
+	// Offset is the index of the Row in its result set. A new result set begins when Offset
+	// is 0.
+	Offset int
+
+	// LastRow is true if the Row is the last of its result set.
+	LastRow bool
+
+	Row Row
+}
+
+// ExportRowHandler is called by Export for each ExportRow as it is received. If it returns
+// an error, Export stops reading the stream and returns that error.
+type ExportRowHandler func(ExportRow) error
+
+// exportService is the search/jobs/export service.
+type exportService struct {
+	Namespace client.Namespace `service:"search/jobs/export"`
+}
+
+// exportServiceV2 is the search/v2/jobs/export service.
+type exportServiceV2 struct {
+	Namespace client.Namespace `service:"search/v2/jobs/export"`
+}
+
+// exportOutputModeQuery sets the output_mode query parameter for an export request.
+type exportOutputModeQuery struct {
+	OutputMode ExportOutputMode `values:"output_mode"`
+}
+
+// Export runs a search in the given Namespace with the export endpoint, calling handler for each
+// Row as it is streamed in the response, without buffering the full set of results.
+//
+// The Client's Timeout applies to the entire export, including reading the streamed results, so it
+// must be long enough for the search to complete.
+func Export(ctx context.Context, c *client.Client, ns client.Namespace, options ExportOptions, handler ExportRowHandler) error {
+	var service interface{} = exportService{Namespace: ns}
+	if options.Version == ExportVersion2 {
+		service = exportServiceV2{Namespace: ns}
+	}
+
+	outputMode := options.OutputMode
+	if outputMode == "" {
+		outputMode = ExportOutputModeJSON
+	}
+
+	decodeStream := decodeExportJSON
+	if outputMode == ExportOutputModeCSV {
+		decodeStream = decodeExportCSV
+	}
+
+	return c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestServiceURL(c, service),
+			client.BuildRequestQueryValues(exportOutputModeQuery{OutputMode: outputMode}),
+			client.BuildRequestBodyValues(options.SearchJobOptions),
+			client.BuildRequestDryRunExempt(),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+			func(r *http.Response) error {
+				return decodeStream(r.Body, handler)
+			},
+		),
+	)
+}
+
+// exportJSONObject is a single object in a JSON export stream.
+type exportJSONObject struct {
+	Preview  bool               `json:"preview"`
+	Offset   int                `json:"offset"`
+	LastRow  bool               `json:"lastrow"`
+	Result   *Row               `json:"result"`
+	Messages []messages.Message `json:"messages"`
+}
+
+// exportMessagesError returns an error wrapping ErrExportFailed if msgs contains an ERROR or FATAL Message.
+func exportMessagesError(msgs []messages.Message) error {
+	for _, msg := range msgs {
+		if msg.Code == "ERROR" || msg.Code == "FATAL" {
+			return fmt.Errorf("%w: %s", ErrExportFailed, messages.Messages{Items: msgs})
+		}
+	}
+
+	return nil
+}
+
+// decodeExportJSON decodes a stream of JSON export objects from r, calling handler for each Row.
+func decodeExportJSON(r io.Reader, handler ExportRowHandler) error {
+	decoder := json.NewDecoder(r)
+
+	for {
+		var object exportJSONObject
+		if err := decoder.Decode(&object); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+
+			return fmt.Errorf("search: unable to decode export stream: %w", err)
+		}
+
+		if err := exportMessagesError(object.Messages); err != nil {
+			return err
+		}
+
+		if object.Result == nil {
+			continue
+		}
+
+		if err := handler(ExportRow{
+			Preview: object.Preview,
+			Offset:  object.Offset,
+			LastRow: object.LastRow,
+			Row:     *object.Result,
+		}); err != nil {
+			return err
+		}
+	}
+}
+
+// decodeExportCSV decodes a CSV export stream from r, calling handler for each Row. Each Row is
+// read before the previous one is handled, so that LastRow can be determined.
+func decodeExportCSV(r io.Reader, handler ExportRowHandler) error {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+
+	fields, err := reader.Read()
+	if err != nil {
+		if err == io.EOF {
+			return nil
+		}
+
+		return fmt.Errorf("search: unable to decode export stream: %w", err)
+	}
+
+	var pending *ExportRow
+	for offset := 0; ; offset++ {
+		record, err := reader.Read()
+		if err != nil && err != io.EOF {
+			return fmt.Errorf("search: unable to decode export stream: %w", err)
+		}
+
+		if pending != nil {
+			pending.LastRow = err == io.EOF
+			if err := handler(*pending); err != nil {
+				return err
+			}
+		}
+
+		if err == io.EOF {
+			return nil
+		}
+
+		row := make(Row, len(fields))
+		for i, field := range fields {
+			if i < len(record) {
+				row[field] = []string{record[i]}
+			}
+		}
+
+		pending = &ExportRow{Offset: offset, Row: row}
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/authenticators"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+func TestExport(t *testing.T) {
+	tests := []struct {
+		name         string
+		inputOptions ExportOptions
+		inputBody    string
+		wantPath     string
+		wantQuery    string
+		wantRows     []ExportRow
+		wantError    error
+	}{
+		{
+			name:         "json",
+			inputOptions: ExportOptions{SearchJobOptions: SearchJobOptions{Search: "| makeresults count=2"}},
+			inputBody: `{"preview":true,"offset":0,"result":{"n":"1"}}
+{"preview":true,"offset":0,"lastrow":true,"result":{"n":"1"}}
+{"preview":false,"offset":0,"result":{"n":"1"}}
+{"preview":false,"offset":1,"lastrow":true,"result":{"n":["2","3"]}}
+`,
+			wantPath:  "/servicesNS/nobody/search/search/jobs/export",
+			wantQuery: "output_mode=json",
+			wantRows: []ExportRow{
+				{Preview: true, Offset: 0, Row: Row{"n": {"1"}}},
+				{Preview: true, Offset: 0, LastRow: true, Row: Row{"n": {"1"}}},
+				{Offset: 0, Row: Row{"n": {"1"}}},
+				{Offset: 1, LastRow: true, Row: Row{"n": {"2", "3"}}},
+			},
+		},
+		{
+			name:         "json no results",
+			inputOptions: ExportOptions{SearchJobOptions: SearchJobOptions{Search: "| makeresults count=0"}},
+			inputBody:    `{"preview":false,"lastrow":true}`,
+			wantPath:     "/servicesNS/nobody/search/search/jobs/export",
+			wantQuery:    "output_mode=json",
+		},
+		{
+			name:         "json fatal message",
+			inputOptions: ExportOptions{SearchJobOptions: SearchJobOptions{Search: "| invalid"}},
+			inputBody:    `{"messages":[{"type":"FATAL","text":"Unknown search command 'invalid'."}]}`,
+			wantPath:     "/servicesNS/nobody/search/search/jobs/export",
+			wantQuery:    "output_mode=json",
+			wantError:    ErrExportFailed,
+		},
+		{
+			name: "csv v2",
+			inputOptions: ExportOptions{
+				SearchJobOptions: SearchJobOptions{Search: "| makeresults count=2"},
+				OutputMode:       ExportOutputModeCSV,
+				Version:          ExportVersion2,
+			},
+			inputBody: "host,count\na,1\n\"b,c\",2\n",
+			wantPath:  "/servicesNS/nobody/search/search/v2/jobs/export",
+			wantQuery: "output_mode=csv",
+			wantRows: []ExportRow{
+				{Offset: 0, Row: Row{"host": {"a"}, "count": {"1"}}},
+				{Offset: 1, LastRow: true, Row: Row{"host": {"b,c"}, "count": {"2"}}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		var gotPath, gotQuery string
+		var gotBody url.Values
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotPath = r.URL.Path
+			gotQuery = r.URL.RawQuery
+			body, _ := io.ReadAll(r.Body)
+			gotBody, _ = url.ParseQuery(string(body))
+
+			fmt.Fprint(w, test.inputBody)
+		}))
+		c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+		var gotRows []ExportRow
+		err := Export(context.Background(), c, client.Namespace{User: "nobody", App: "search"}, test.inputOptions, func(row ExportRow) error {
+			gotRows = append(gotRows, row)
+			return nil
+		})
+		server.Close()
+
+		if !errors.Is(err, test.wantError) {
+			t.Errorf("%s: Export() got error %v, want %v", test.name, err, test.wantError)
+		}
+
+		if gotPath != test.wantPath {
+			t.Errorf("%s: Export() got path %s, want %s", test.name, gotPath, test.wantPath)
+		}
+
+		if gotQuery != test.wantQuery {
+			t.Errorf("%s: Export() got query %s, want %s", test.name, gotQuery, test.wantQuery)
+		}
+
+		if gotSearch := gotBody.Get("search"); gotSearch != test.inputOptions.Search {
+			t.Errorf("%s: Export() got search %q, want %q", test.name, gotSearch, test.inputOptions.Search)
+		}
+
+		if !reflect.DeepEqual(gotRows, test.wantRows) {
+			t.Errorf("%s: Export() got\n%#v, want\n%#v", test.name, gotRows, test.wantRows)
+		}
+	}
+}
+
+func TestExport_handlerError(t *testing.T) {
+	wantErr := errors.New("stop")
+
+	tests := []struct {
+		name         string
+		inputDecoder func(io.Reader, ExportRowHandler) error
+		inputBody    string
+	}{
+		{
+			name:         "json",
+			inputDecoder: decodeExportJSON,
+			inputBody:    `{"result":{"n":"1"}}{"result":{"n":"2"}}`,
+		},
+		{
+			name:         "csv",
+			inputDecoder: decodeExportCSV,
+			inputBody:    "n\n1\n2\n",
+		},
+	}
+
+	for _, test := range tests {
+		calls := 0
+		err := test.inputDecoder(strings.NewReader(test.inputBody), func(ExportRow) error {
+			calls++
+			return wantErr
+		})
+
+		if err != wantErr {
+			t.Errorf("%s: got error %v, want %v", test.name, err, wantErr)
+		}
+
+		if calls != 1 {
+			t.Errorf("%s: got %d handler calls, want 1", test.name, calls)
+		}
+	}
+}
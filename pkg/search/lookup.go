@@ -0,0 +1,41 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"strings"
+
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+// InputLookup returns the contents of a lookup, as Rows, by running "| inputlookup" as a Oneshot
+// search in the given Namespace. lookupName may be the name of a lookup definition or of a lookup
+// table file.
+func InputLookup(ctx context.Context, c *client.Client, ns client.Namespace, lookupName string) ([]Row, error) {
+	results, err := Oneshot(ctx, c, ns, SearchJobOptions{
+		Search: "| inputlookup " + quoteSearchString(lookupName),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return results.Rows, nil
+}
+
+// quoteSearchString returns s as a double-quoted search string, with backslashes and double quotes escaped.
+func quoteSearchString(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
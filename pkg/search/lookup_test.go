@@ -0,0 +1,66 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/authenticators"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+func TestInputLookup(t *testing.T) {
+	var gotPath string
+	var gotBody url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		body, _ := io.ReadAll(r.Body)
+		gotBody, _ = url.ParseQuery(string(body))
+
+		fmt.Fprint(w, `{"fields":["user","role"],"results":[{"user":"admin","role":"admin"},{"user":"bob","role":"user"}]}`)
+	}))
+	defer server.Close()
+
+	c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+	got, err := InputLookup(context.Background(), c, client.Namespace{User: "nobody", App: "search"}, `users "all".csv`)
+	if err != nil {
+		t.Fatalf("InputLookup() returned error: %s", err)
+	}
+
+	if wantPath := "/servicesNS/nobody/search/search/jobs"; gotPath != wantPath {
+		t.Errorf("InputLookup() got path %s, want %s", gotPath, wantPath)
+	}
+
+	if gotSearch, wantSearch := gotBody.Get("search"), `| inputlookup "users \"all\".csv"`; gotSearch != wantSearch {
+		t.Errorf("InputLookup() got search %q, want %q", gotSearch, wantSearch)
+	}
+
+	want := []Row{
+		{"user": {"admin"}, "role": {"admin"}},
+		{"user": {"bob"}, "role": {"user"}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("InputLookup() got\n%#v, want\n%#v", got, want)
+	}
+}
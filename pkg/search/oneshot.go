@@ -0,0 +1,86 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/messages"
+)
+
+// oneshotRequest defines the parameters of a oneshot search request.
+type oneshotRequest struct {
+	SearchJobOptions `values:",anonymize"`
+
+	Count int `values:"count"`
+}
+
+// Oneshot runs a search in the given Namespace with ExecModeOneshot, returning all of its Results.
+// The ExecMode of options is ignored.
+func Oneshot(ctx context.Context, c *client.Client, ns client.Namespace, options SearchJobOptions) (Results, error) {
+	options.ExecMode = ExecModeOneshot
+
+	var results Results
+
+	err := c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestServiceURL(c, SearchJob{ID: client.ID{Namespace: ns}}),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestBodyValues(oneshotRequest{SearchJobOptions: options}),
+			client.BuildRequestDryRunExempt(),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+			client.HandleResponseJSON(&results),
+		),
+	)
+
+	return results, err
+}
+
+// Blocking runs a search in the given Namespace with ExecModeBlocking, returning the completed
+// SearchJob and its Results, as determined by resultsOptions. The ExecMode of options is ignored.
+//
+// An error wrapping ErrJobFailed is returned if the job fails. The SearchJob remains available
+// until its TTL expires, unless it is cancelled.
+//
+// Like Oneshot, the job is created even in dry-run mode, as running a search doesn't modify
+// configuration.
+func Blocking(ctx context.Context, c *client.Client, ns client.Namespace, options SearchJobOptions, resultsOptions ResultsOptions) (SearchJob, Results, error) {
+	options.ExecMode = ExecModeBlocking
+
+	job, err := Create(ctx, c, ns, options)
+	if err != nil {
+		return SearchJob{}, Results{}, err
+	}
+
+	if err := c.ReadContext(ctx, &job); err != nil {
+		return job, Results{}, err
+	}
+
+	if job.Content.IsFailed {
+		return job, Results{}, fmt.Errorf("%w: %s: %s", ErrJobFailed, job.ID.Title, messages.Messages{Items: job.Content.Messages})
+	}
+
+	results, err := job.Results(ctx, c, resultsOptions)
+
+	return job, results, err
+}
@@ -0,0 +1,114 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/authenticators"
+	"github.com/splunk/go-splunk-client/pkg/client"
+)
+
+func TestOneshot(t *testing.T) {
+	var gotBody url.Values
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotBody, _ = url.ParseQuery(string(body))
+
+		fmt.Fprint(w, `{"fields":[{"name":"count"}],"results":[{"count":"1"},{"count":"2"}]}`)
+	}))
+	defer server.Close()
+
+	c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+	got, err := Oneshot(context.Background(), c, client.Namespace{}, SearchJobOptions{Search: "| makeresults count=2", ExecMode: ExecModeNormal})
+	if err != nil {
+		t.Fatalf("Oneshot() returned error: %s", err)
+	}
+
+	wantBody := url.Values{
+		"search":    []string{"| makeresults count=2"},
+		"exec_mode": []string{"oneshot"},
+		"count":     []string{"0"},
+	}
+	if !reflect.DeepEqual(gotBody, wantBody) {
+		t.Errorf("Oneshot() got body\n%#v, want\n%#v", gotBody, wantBody)
+	}
+
+	want := Results{
+		Fields: []string{"count"},
+		Rows:   []Row{{"count": {"1"}}, {"count": {"2"}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Oneshot() got\n%#v, want\n%#v", got, want)
+	}
+}
+
+func TestBlocking(t *testing.T) {
+	server := httptest.NewServer(&testJobServer{})
+	defer server.Close()
+
+	c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+	job, got, err := Blocking(context.Background(), c, client.Namespace{User: "nobody", App: "search"}, SearchJobOptions{Search: "| makeresults"}, ResultsOptions{})
+	if err != nil {
+		t.Fatalf("Blocking() returned error: %s", err)
+	}
+
+	if !job.Content.IsDone {
+		t.Errorf("Blocking() returned job that isn't done")
+	}
+
+	want := Results{
+		Fields: []string{"count"},
+		Rows:   []Row{{"count": {"42"}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Blocking() got\n%#v, want\n%#v", got, want)
+	}
+}
+
+func TestBlocking_dryRun(t *testing.T) {
+	server := httptest.NewServer(&testJobServer{})
+	defer server.Close()
+
+	dryRun := &client.DryRun{}
+	c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}, DryRun: dryRun}
+
+	_, got, err := Blocking(context.Background(), c, client.Namespace{User: "nobody", App: "search"}, SearchJobOptions{Search: "| makeresults"}, ResultsOptions{})
+	if err != nil {
+		t.Fatalf("Blocking() returned error: %s", err)
+	}
+
+	want := Results{
+		Fields: []string{"count"},
+		Rows:   []Row{{"count": {"42"}}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Blocking() got\n%#v, want\n%#v", got, want)
+	}
+
+	if requests := dryRun.Requests(); len(requests) != 0 {
+		t.Errorf("Blocking() recorded requests: %#v", requests)
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/messages"
+)
+
+// Row is a single result or event. Each field may have multiple values.
+type Row map[string][]string
+
+// Get returns the first value of field, or an empty string if it has no values.
+func (row Row) Get(field string) string {
+	if values := row[field]; len(values) > 0 {
+		return values[0]
+	}
+
+	return ""
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling. Field values may be strings or lists of strings.
+func (row *Row) UnmarshalJSON(data []byte) error {
+	var rawFields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &rawFields); err != nil {
+		return err
+	}
+
+	newRow := make(Row, len(rawFields))
+	for field, rawValue := range rawFields {
+		var value string
+		if err := json.Unmarshal(rawValue, &value); err == nil {
+			newRow[field] = []string{value}
+			continue
+		}
+
+		var values []string
+		if err := json.Unmarshal(rawValue, &values); err != nil {
+			return fmt.Errorf("search: unable to decode value of field %s: %s", field, rawValue)
+		}
+
+		newRow[field] = values
+	}
+
+	*row = newRow
+
+	return nil
+}
+
+// Results is a set of Rows returned for a SearchJob.
+type Results struct {
+	Preview    bool
+	InitOffset int
+	Fields     []string
+	Rows       []Row
+	Messages   []messages.Message
+}
+
+// resultField is a field name, which may be returned as a string or an object with a name.
+type resultField string
+
+// UnmarshalJSON implements custom JSON unmarshaling.
+func (field *resultField) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		*field = resultField(name)
+		return nil
+	}
+
+	var object struct {
+		Name string `json:"name"`
+	}
+	if err := json.Unmarshal(data, &object); err != nil {
+		return err
+	}
+
+	*field = resultField(object.Name)
+
+	return nil
+}
+
+// UnmarshalJSON implements custom JSON unmarshaling.
+func (results *Results) UnmarshalJSON(data []byte) error {
+	var response struct {
+		Preview    bool               `json:"preview"`
+		InitOffset int                `json:"init_offset"`
+		Fields     []resultField      `json:"fields"`
+		Rows       []Row              `json:"results"`
+		Messages   []messages.Message `json:"messages"`
+	}
+
+	if err := json.Unmarshal(data, &response); err != nil {
+		return err
+	}
+
+	newResults := Results{
+		Preview:    response.Preview,
+		InitOffset: response.InitOffset,
+		Rows:       response.Rows,
+		Messages:   response.Messages,
+	}
+
+	for _, field := range response.Fields {
+		newResults.Fields = append(newResults.Fields, string(field))
+	}
+
+	*results = newResults
+
+	return nil
+}
+
+// ResultsOptions defines the parameters used to retrieve Results for a SearchJob.
+type ResultsOptions struct {
+	// Count is the maximum number of Rows to return. If unset, Splunk returns 100 Rows.
+	// If explicitly set to 0, all Rows are returned.
+	Count attributes.Explicit[int] `values:"count,omitzero"`
+
+	// Offset is the index of the first Row to return.
+	Offset int `values:"offset,omitzero"`
+
+	// Fields limits the fields returned for each Row.
+	Fields []string `values:"f,omitzero"`
+
+	// Search is a post-processing search applied to the Rows.
+	Search string `values:"search,omitzero"`
+}
+
+// fetchResults returns the Results from the given endpoint of the SearchJob. An empty Results is
+// returned if the endpoint has no content available.
+func (job SearchJob) fetchResults(ctx context.Context, c *client.Client, endpoint string, options ResultsOptions) (Results, error) {
+	var results Results
+
+	handleResults := client.ComposeResponseHandler(
+		client.HandleResponseCode(http.StatusNotFound, client.HandleResponseJSONMessagesCustomError(client.ErrorNotFound)),
+		client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+		client.HandleResponseJSON(&results),
+	)
+
+	err := c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodGet),
+			client.BuildRequestEntryActionURL(c, job, endpoint),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestQueryValues(options),
+			client.BuildRequestAuthenticate(c),
+		),
+		func(r *http.Response) error {
+			if r.StatusCode == http.StatusNoContent {
+				return nil
+			}
+
+			return handleResults(r)
+		},
+	)
+
+	return results, err
+}
+
+// Results returns the transformed results of a completed SearchJob.
+func (job SearchJob) Results(ctx context.Context, c *client.Client, options ResultsOptions) (Results, error) {
+	return job.fetchResults(ctx, c, "results", options)
+}
+
+// Events returns the untransformed events of a SearchJob.
+func (job SearchJob) Events(ctx context.Context, c *client.Client, options ResultsOptions) (Results, error) {
+	return job.fetchResults(ctx, c, "events", options)
+}
+
+// ResultsPreview returns a preview of the results of a SearchJob that may still be running.
+func (job SearchJob) ResultsPreview(ctx context.Context, c *client.Client, options ResultsOptions) (Results, error) {
+	return job.fetchResults(ctx, c, "results_preview", options)
+}
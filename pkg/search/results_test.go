@@ -0,0 +1,100 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"testing"
+
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+	"github.com/splunk/go-splunk-client/pkg/messages"
+)
+
+func TestResults_UnmarshalJSON(t *testing.T) {
+	tests := checks.JSONUnmarshalTestCases{
+		{
+			Name:        "empty",
+			InputString: `{}`,
+			Want:        Results{},
+		},
+		{
+			Name:        "string fields",
+			InputString: `{"preview":true,"init_offset":10,"fields":["host","count"],"results":[{"host":"a","count":"1"}]}`,
+			Want: Results{
+				Preview:    true,
+				InitOffset: 10,
+				Fields:     []string{"host", "count"},
+				Rows:       []Row{{"host": {"a"}, "count": {"1"}}},
+			},
+		},
+		{
+			Name:        "object fields",
+			InputString: `{"fields":[{"name":"host"},{"name":"count","groupby_rank":"0"}],"results":[]}`,
+			Want: Results{
+				Fields: []string{"host", "count"},
+				Rows:   []Row{},
+			},
+		},
+		{
+			Name:        "multivalue",
+			InputString: `{"results":[{"host":["a","b"]}]}`,
+			Want: Results{
+				Rows: []Row{{"host": {"a", "b"}}},
+			},
+		},
+		{
+			Name:        "messages",
+			InputString: `{"messages":[{"type":"WARN","text":"truncated"}]}`,
+			Want: Results{
+				Messages: []messages.Message{{Code: "WARN", Value: "truncated"}},
+			},
+		},
+		{
+			Name:        "invalid value",
+			InputString: `{"results":[{"host":1}]}`,
+			Want:        Results{},
+			WantError:   true,
+		},
+	}
+
+	tests.Test(t)
+}
+
+func TestRow_Get(t *testing.T) {
+	tests := []struct {
+		name       string
+		inputRow   Row
+		inputField string
+		want       string
+	}{
+		{
+			name:       "missing",
+			inputRow:   Row{},
+			inputField: "host",
+			want:       "",
+		},
+		{
+			name:       "multivalue",
+			inputRow:   Row{"host": {"a", "b"}},
+			inputField: "host",
+			want:       "a",
+		},
+	}
+
+	for _, test := range tests {
+		if got := test.inputRow.Get(test.inputField); got != test.want {
+			t.Errorf("%s: Get() got %q, want %q", test.name, got, test.want)
+		}
+	}
+}
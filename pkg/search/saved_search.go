@@ -0,0 +1,217 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/entry"
+)
+
+// DispatchOptions defines the parameters used to dispatch a saved search.
+type DispatchOptions struct {
+	// Dispatch overrides the saved search's dispatch.* parameters, such as "earliest_time" for
+	// dispatch.earliest_time.
+	Dispatch attributes.Parameters `values:"dispatch,omitzero"`
+
+	// Args sets the values of args.* parameters, which are substituted for $args.<name>$ tokens
+	// in the saved search's search string.
+	Args attributes.Parameters `values:"args,omitzero"`
+
+	TriggerActions attributes.Explicit[bool] `values:"trigger_actions,omitzero"`
+	ForceDispatch  attributes.Explicit[bool] `values:"force_dispatch,omitzero"`
+}
+
+// Dispatch runs the given saved search, returning a SearchJob that can be polled with Wait.
+//
+// Like Create, the job is dispatched even in dry-run mode, as running a search doesn't modify
+// configuration.
+func Dispatch(ctx context.Context, c *client.Client, savedSearch entry.SavedSearch, options DispatchOptions) (SearchJob, error) {
+	response := struct {
+		Sid string `json:"sid"`
+	}{}
+
+	if err := c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestEntryActionURL(c, savedSearch, "dispatch"),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestBodyValues(options),
+			client.BuildRequestDryRunExempt(),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseCode(http.StatusNotFound, client.HandleResponseJSONMessagesCustomError(client.ErrorNotFound)),
+			client.HandleResponseRequireCode(http.StatusCreated, client.HandleResponseJSONMessagesError()),
+			client.HandleResponseJSON(&response),
+		),
+	); err != nil {
+		return SearchJob{}, err
+	}
+
+	return SearchJob{ID: client.ID{Namespace: savedSearch.ID.Namespace, Title: response.Sid}}, nil
+}
+
+// History returns the SearchJobs that have been run for the given saved search and are still available.
+func History(ctx context.Context, c *client.Client, savedSearch entry.SavedSearch) ([]SearchJob, error) {
+	var jobs []SearchJob
+
+	err := c.ListEntryActionContext(ctx, &jobs, savedSearch, "history")
+
+	return jobs, err
+}
+
+// scheduledTimesQuery defines the query parameters for a scheduled_times request.
+type scheduledTimesQuery struct {
+	EarliestTime string `values:"earliest_time"`
+	LatestTime   string `values:"latest_time"`
+}
+
+// ScheduledTimes returns the times the given saved search is scheduled to run between earliestTime
+// and latestTime, which may be relative time modifiers such as "-1h" or "+1d".
+func ScheduledTimes(ctx context.Context, c *client.Client, savedSearch entry.SavedSearch, earliestTime string, latestTime string) ([]time.Time, error) {
+	var scheduled struct {
+		ID      client.ID
+		Content struct {
+			ScheduledTimes []int64 `json:"scheduled_times"`
+		} `json:"content"`
+	}
+
+	if err := c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodGet),
+			client.BuildRequestEntryActionURL(c, savedSearch, "scheduled_times"),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestQueryValues(scheduledTimesQuery{EarliestTime: earliestTime, LatestTime: latestTime}),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseCode(http.StatusNotFound, client.HandleResponseJSONMessagesCustomError(client.ErrorNotFound)),
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+			client.HandleResponseEntry(&scheduled),
+		),
+	); err != nil {
+		return nil, err
+	}
+
+	times := make([]time.Time, len(scheduled.Content.ScheduledTimes))
+	for i, scheduledTime := range scheduled.Content.ScheduledTimes {
+		times[i] = time.Unix(scheduledTime, 0)
+	}
+
+	return times, nil
+}
+
+// Acknowledge acknowledges the suppression of alerts from the given saved search, resuming alerting.
+func Acknowledge(ctx context.Context, c *client.Client, savedSearch entry.SavedSearch) error {
+	return c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestEntryActionURL(c, savedSearch, "acknowledge"),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseCode(http.StatusNotFound, client.HandleResponseJSONMessagesCustomError(client.ErrorNotFound)),
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+		),
+	)
+}
+
+// Suppression is the alert suppression state of a saved search.
+type Suppression struct {
+	Suppressed     bool   `json:"suppressed"`
+	Expiration     string `json:"expiration"`
+	SuppressionKey string `json:"suppressionKey"`
+}
+
+// SuppressionState returns the alert suppression state of the given saved search.
+func SuppressionState(ctx context.Context, c *client.Client, savedSearch entry.SavedSearch) (Suppression, error) {
+	var suppress struct {
+		ID      client.ID
+		Content Suppression `json:"content"`
+	}
+
+	err := c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodGet),
+			client.BuildRequestEntryActionURL(c, savedSearch, "suppress"),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseCode(http.StatusNotFound, client.HandleResponseJSONMessagesCustomError(client.ErrorNotFound)),
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+			client.HandleResponseEntry(&suppress),
+		),
+	)
+
+	return suppress.Content, err
+}
+
+// FiredAlertGroupContent defines the Content of a FiredAlertGroup.
+type FiredAlertGroupContent struct {
+	TriggeredAlertCount int `json:"triggered_alert_count"`
+}
+
+// FiredAlertGroup is the set of fired alerts for a single saved search. FiredAlertGroups can be
+// listed with Client.ListNamespace, and their FiredAlerts with ListFiredAlerts.
+type FiredAlertGroup struct {
+	ID      client.ID              `service:"alerts/fired_alerts"`
+	Content FiredAlertGroupContent `json:"content"`
+}
+
+// FiredAlertContent defines the Content of a FiredAlert.
+type FiredAlertContent struct {
+	Sid                    string `json:"sid"`
+	SavedSearchName        string `json:"savedsearch_name"`
+	AlertType              string `json:"alert_type"`
+	Actions                string `json:"actions"`
+	DigestMode             bool   `json:"digest_mode"`
+	Severity               int    `json:"severity"`
+	TriggerTime            int64  `json:"trigger_time"`
+	TriggerTimeRendered    string `json:"trigger_time_rendered"`
+	ExpirationTimeRendered string `json:"expiration_time_rendered"`
+	TriggeredAlerts        string `json:"triggered_alerts"`
+}
+
+// FiredAlert is a single triggered alert. It can be deleted with Client.Delete.
+type FiredAlert struct {
+	ID      client.ID         `service:"alerts/fired_alerts"`
+	Content FiredAlertContent `json:"content"`
+}
+
+// Job returns the SearchJob that triggered the FiredAlert.
+func (alert FiredAlert) Job() SearchJob {
+	return SearchJob{ID: client.ID{Namespace: alert.ID.Namespace, Title: alert.Content.Sid}}
+}
+
+// ListFiredAlerts returns the FiredAlerts of the given FiredAlertGroup. A FiredAlertGroup with the
+// Title "-" returns the FiredAlerts of all saved searches.
+func ListFiredAlerts(ctx context.Context, c *client.Client, group FiredAlertGroup) ([]FiredAlert, error) {
+	var alerts []FiredAlert
+
+	err := c.ListIDContext(ctx, &alerts, group.ID)
+
+	return alerts, err
+}
@@ -0,0 +1,258 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/authenticators"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/entry"
+)
+
+// newTestSavedSearchServer returns an httptest.Server that responds to saved search endpoints for
+// the saved search "test" in the nobody/search namespace, recording each request's body.
+func newTestSavedSearchServer(bodies map[string]url.Values) *httptest.Server {
+	const prefix = "/servicesNS/nobody/search"
+	const idPrefix = "https://localhost:8089" + prefix
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies[r.Method+" "+r.URL.Path], _ = url.ParseQuery(string(body))
+
+		switch r.Method + " " + r.URL.Path {
+		case "POST " + prefix + "/saved/searches/test/dispatch":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"sid":"scheduler__test"}`)
+		case "GET " + prefix + "/saved/searches/test/history":
+			fmt.Fprintf(w, `{"entry":[{"id":"%s/search/jobs/sid1","content":{"isDone":true}},{"id":"%s/search/jobs/sid2","content":{"isDone":false}}]}`, idPrefix, idPrefix)
+		case "GET " + prefix + "/saved/searches/test/scheduled_times":
+			fmt.Fprintf(w, `{"entry":[{"id":"%s/saved/searches/test","content":{"scheduled_times":[1600000000,1600003600]}}]}`, idPrefix)
+		case "POST " + prefix + "/saved/searches/test/acknowledge":
+			fmt.Fprint(w, `{}`)
+		case "GET " + prefix + "/saved/searches/test/suppress":
+			fmt.Fprintf(w, `{"entry":[{"id":"%s/saved/searches/test/suppress","content":{"suppressed":true,"expiration":"59 seconds","suppressionKey":"key"}}]}`, idPrefix)
+		case "GET " + prefix + "/alerts/fired_alerts/-":
+			fmt.Fprintf(w, `{"entry":[{"id":"%s/alerts/fired_alerts/alert1","content":{"sid":"sid1","savedsearch_name":"test","severity":3,"trigger_time":1600000000,"digest_mode":true}}]}`, idPrefix)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"messages":[{"type":"ERROR","text":"not found"}]}`)
+		}
+	}))
+}
+
+func TestSavedSearch(t *testing.T) {
+	ns := client.Namespace{User: "nobody", App: "search"}
+	savedSearch := entry.SavedSearch{ID: client.ID{Namespace: ns, Title: "test"}}
+	missingSavedSearch := entry.SavedSearch{ID: client.ID{Namespace: ns, Title: "missing"}}
+
+	tests := []struct {
+		name      string
+		inputFunc func(context.Context, *client.Client) (interface{}, error)
+		want      interface{}
+		wantKey   string
+		wantBody  url.Values
+		wantError bool
+	}{
+		{
+			name: "dispatch",
+			inputFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				return Dispatch(ctx, c, savedSearch, DispatchOptions{
+					Dispatch:       attributes.Parameters{"earliest_time": "-1h"},
+					Args:           attributes.Parameters{"host": "a"},
+					TriggerActions: attributes.NewExplicit(true),
+				})
+			},
+			want:    SearchJob{ID: client.ID{Namespace: ns, Title: "scheduler__test"}},
+			wantKey: "POST /servicesNS/nobody/search/saved/searches/test/dispatch",
+			wantBody: url.Values{
+				"dispatch.earliest_time": []string{"-1h"},
+				"args.host":              []string{"a"},
+				"trigger_actions":        []string{"true"},
+			},
+		},
+		{
+			name: "dispatch missing",
+			inputFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				return Dispatch(ctx, c, missingSavedSearch, DispatchOptions{})
+			},
+			want:      SearchJob{},
+			wantError: true,
+		},
+		{
+			name: "history",
+			inputFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				jobs, err := History(ctx, c, savedSearch)
+				sids := make([]string, len(jobs))
+				for i, job := range jobs {
+					sids[i] = fmt.Sprintf("%s:%v", job.ID.Title, job.Content.IsDone)
+				}
+				return sids, err
+			},
+			want: []string{"sid1:true", "sid2:false"},
+		},
+		{
+			name: "scheduled times",
+			inputFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				return ScheduledTimes(ctx, c, savedSearch, "-1h", "+1h")
+			},
+			want: []time.Time{time.Unix(1600000000, 0), time.Unix(1600003600, 0)},
+		},
+		{
+			name: "acknowledge",
+			inputFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				return nil, Acknowledge(ctx, c, savedSearch)
+			},
+			wantKey:  "POST /servicesNS/nobody/search/saved/searches/test/acknowledge",
+			wantBody: url.Values{},
+		},
+		{
+			name: "suppression state",
+			inputFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				return SuppressionState(ctx, c, savedSearch)
+			},
+			want: Suppression{Suppressed: true, Expiration: "59 seconds", SuppressionKey: "key"},
+		},
+		{
+			name: "fired alerts",
+			inputFunc: func(ctx context.Context, c *client.Client) (interface{}, error) {
+				alerts, err := ListFiredAlerts(ctx, c, FiredAlertGroup{ID: client.ID{Namespace: ns, Title: "-"}})
+				jobs := make([]SearchJob, len(alerts))
+				for i, alert := range alerts {
+					jobs[i] = alert.Job()
+				}
+				return jobs, err
+			},
+			want: []SearchJob{{ID: client.ID{Namespace: ns, Title: "sid1"}}},
+		},
+	}
+
+	for _, test := range tests {
+		bodies := map[string]url.Values{}
+		server := newTestSavedSearchServer(bodies)
+		c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+		got, err := test.inputFunc(context.Background(), c)
+		server.Close()
+
+		gotError := err != nil
+		if gotError != test.wantError {
+			t.Errorf("%s: returned error? %v (%s)", test.name, gotError, err)
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got\n%#v, want\n%#v", test.name, got, test.want)
+		}
+
+		if test.wantKey != "" && !reflect.DeepEqual(bodies[test.wantKey], test.wantBody) {
+			t.Errorf("%s: got body\n%#v, want\n%#v", test.name, bodies[test.wantKey], test.wantBody)
+		}
+	}
+}
+
+func TestDispatch_dryRun(t *testing.T) {
+	bodies := map[string]url.Values{}
+	server := newTestSavedSearchServer(bodies)
+	defer server.Close()
+
+	dryRun := &client.DryRun{}
+	c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}, DryRun: dryRun}
+	ns := client.Namespace{User: "nobody", App: "search"}
+
+	got, err := Dispatch(context.Background(), c, entry.SavedSearch{ID: client.ID{Namespace: ns, Title: "test"}}, DispatchOptions{})
+	if err != nil {
+		t.Fatalf("Dispatch() returned error: %s", err)
+	}
+
+	want := SearchJob{ID: client.ID{Namespace: ns, Title: "scheduler__test"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Dispatch() got\n%#v, want\n%#v", got, want)
+	}
+
+	if requests := dryRun.Requests(); len(requests) != 0 {
+		t.Errorf("Dispatch() recorded requests: %#v", requests)
+	}
+}
+
+// newTestPagedServer returns an httptest.Server that serves total entries, one per page, with IDs
+// beneath idPrefix, regardless of the requested page size.
+func newTestPagedServer(idPrefix string, total int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+
+		fmt.Fprintf(w, `{"paging":{"total":%d,"perPage":1,"offset":%d},"entry":[{"id":"%s/sid%d","content":{"sid":"sid%d"}}]}`, total, offset, idPrefix, offset, offset)
+	}))
+}
+
+func TestSavedSearch_paging(t *testing.T) {
+	ns := client.Namespace{User: "nobody", App: "search"}
+
+	tests := []struct {
+		name      string
+		inputFunc func(context.Context, *client.Client) ([]SearchJob, error)
+		want      []string
+	}{
+		{
+			name: "history",
+			inputFunc: func(ctx context.Context, c *client.Client) ([]SearchJob, error) {
+				return History(ctx, c, entry.SavedSearch{ID: client.ID{Namespace: ns, Title: "test"}})
+			},
+			want: []string{"sid0", "sid1", "sid2"},
+		},
+		{
+			name: "fired alerts",
+			inputFunc: func(ctx context.Context, c *client.Client) ([]SearchJob, error) {
+				alerts, err := ListFiredAlerts(ctx, c, FiredAlertGroup{ID: client.ID{Namespace: ns, Title: "-"}})
+				jobs := make([]SearchJob, len(alerts))
+				for i, alert := range alerts {
+					jobs[i] = alert.Job()
+				}
+				return jobs, err
+			},
+			want: []string{"sid0", "sid1", "sid2"},
+		},
+	}
+
+	for _, test := range tests {
+		server := newTestPagedServer("https://localhost:8089/servicesNS/nobody/search/search/jobs", 3)
+		c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+
+		jobs, err := test.inputFunc(context.Background(), c)
+		server.Close()
+
+		if err != nil {
+			t.Errorf("%s: returned error: %s", test.name, err)
+		}
+
+		got := make([]string, len(jobs))
+		for i, job := range jobs {
+			got[i] = job.ID.Title
+		}
+
+		if !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: got\n%#v, want\n%#v", test.name, got, test.want)
+		}
+	}
+}
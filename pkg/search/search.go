@@ -0,0 +1,244 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package search implements running searches and retrieving their results with the Splunk REST API.
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/messages"
+)
+
+// ErrJobFailed is returned by Wait when a SearchJob has failed.
+var ErrJobFailed = errors.New("search: job failed")
+
+// ExecMode is the execution mode of a SearchJob.
+type ExecMode string
+
+const (
+	// ExecModeUndefined is the zero value for ExecMode, which uses Splunk's default of ExecModeNormal.
+	ExecModeUndefined ExecMode = ""
+
+	// ExecModeNormal returns the job's sid immediately, while the search runs asynchronously.
+	ExecModeNormal ExecMode = "normal"
+
+	// ExecModeBlocking returns the job's sid after the search has completed.
+	ExecModeBlocking ExecMode = "blocking"
+
+	// ExecModeOneshot returns the search's results directly, without creating a job that can
+	// be read later. Use Oneshot to run searches in this mode.
+	ExecModeOneshot ExecMode = "oneshot"
+)
+
+// DispatchState is the state of a SearchJob.
+type DispatchState string
+
+const (
+	DispatchStateQueued         DispatchState = "QUEUED"
+	DispatchStateParsing        DispatchState = "PARSING"
+	DispatchStateRunning        DispatchState = "RUNNING"
+	DispatchStatePaused         DispatchState = "PAUSED"
+	DispatchStateFinalizing     DispatchState = "FINALIZING"
+	DispatchStateFailed         DispatchState = "FAILED"
+	DispatchStateDone           DispatchState = "DONE"
+	DispatchStateInternalCancel DispatchState = "INTERNAL_CANCEL"
+	DispatchStateUserCancel     DispatchState = "USER_CANCEL"
+	DispatchStateBadInputCancel DispatchState = "BAD_INPUT_CANCEL"
+)
+
+// SearchJobOptions defines the parameters used to create a SearchJob.
+type SearchJobOptions struct {
+	// Search is the search string to run. It must begin with a generating command, such
+	// as "search" or "| makeresults".
+	Search string `values:"search"`
+
+	// ID optionally sets the sid of the created SearchJob.
+	ID attributes.Explicit[string] `values:"id,omitzero"`
+
+	EarliestTime      attributes.Explicit[string] `values:"earliest_time,omitzero"`
+	LatestTime        attributes.Explicit[string] `values:"latest_time,omitzero"`
+	IndexEarliest     attributes.Explicit[string] `values:"index_earliest,omitzero"`
+	IndexLatest       attributes.Explicit[string] `values:"index_latest,omitzero"`
+	Now               attributes.Explicit[string] `values:"now,omitzero"`
+	TimeFormat        attributes.Explicit[string] `values:"time_format,omitzero"`
+	ExecMode          ExecMode                    `values:"exec_mode,omitzero"`
+	MaxCount          attributes.Explicit[int]    `values:"max_count,omitzero"`
+	MaxTime           attributes.Explicit[int]    `values:"max_time,omitzero"`
+	StatusBuckets     attributes.Explicit[int]    `values:"status_buckets,omitzero"`
+	TTL               attributes.Explicit[int]    `values:"timeout,omitzero"`
+	AutoCancel        attributes.Explicit[int]    `values:"auto_cancel,omitzero"`
+	AutoFinalizeCount attributes.Explicit[int]    `values:"auto_finalize_ec,omitzero"`
+	EnableLookups     attributes.Explicit[bool]   `values:"enable_lookups,omitzero"`
+	RequiredFields    []string                    `values:"rf,omitzero"`
+}
+
+// SearchJobContent defines the Content of a SearchJob, as returned by the Splunk REST API.
+type SearchJobContent struct {
+	Sid           string             `json:"sid"`
+	Search        string             `json:"search"`
+	DispatchState DispatchState      `json:"dispatchState"`
+	DoneProgress  float64            `json:"doneProgress"`
+	EarliestTime  string             `json:"earliestTime"`
+	LatestTime    string             `json:"latestTime"`
+	EventCount    int                `json:"eventCount"`
+	ResultCount   int                `json:"resultCount"`
+	ScanCount     int                `json:"scanCount"`
+	IsDone        bool               `json:"isDone"`
+	IsFailed      bool               `json:"isFailed"`
+	IsFinalized   bool               `json:"isFinalized"`
+	IsPaused      bool               `json:"isPaused"`
+	IsSaved       bool               `json:"isSaved"`
+	IsZombie      bool               `json:"isZombie"`
+	RunDuration   float64            `json:"runDuration"`
+	TTL           int                `json:"ttl"`
+	Messages      []messages.Message `json:"messages"`
+}
+
+// SearchJob is a Splunk search job. Its ID's Title is the job's sid.
+//
+// A SearchJob is created with Create, and its current status can be read with Client.Read.
+type SearchJob struct {
+	ID      client.ID        `service:"search/jobs"`
+	Content SearchJobContent `json:"content"`
+}
+
+// Create creates a new SearchJob in the given Namespace. The returned SearchJob has only its ID
+// populated. Use Wait to poll its status until it is done.
+//
+// The job is created even in dry-run mode, as running a search doesn't modify configuration.
+func Create(ctx context.Context, c *client.Client, ns client.Namespace, options SearchJobOptions) (SearchJob, error) {
+	job := SearchJob{ID: client.ID{Namespace: ns}}
+
+	response := struct {
+		Sid string `json:"sid"`
+	}{}
+
+	if err := c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestServiceURL(c, job),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestBodyValues(options),
+			client.BuildRequestDryRunExempt(),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseRequireCode(http.StatusCreated, client.HandleResponseJSONMessagesError()),
+			client.HandleResponseJSON(&response),
+		),
+	); err != nil {
+		return SearchJob{}, err
+	}
+
+	job.ID.Title = response.Sid
+
+	return job, nil
+}
+
+// Wait reads the SearchJob's status every interval until it is done, updating job in-place. It
+// returns an error wrapping ErrJobFailed if the job fails, or the Context's error if it is done
+// before the job.
+func (job *SearchJob) Wait(ctx context.Context, c *client.Client, interval time.Duration) error {
+	for {
+		if err := c.ReadContext(ctx, job); err != nil {
+			return err
+		}
+
+		if job.Content.IsFailed {
+			return fmt.Errorf("%w: %s: %s", ErrJobFailed, job.ID.Title, messages.Messages{Items: job.Content.Messages})
+		}
+
+		if job.Content.IsDone {
+			return nil
+		}
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// controlRequest defines the parameters of a request to a SearchJob's control endpoint.
+type controlRequest struct {
+	Action   string                   `values:"action"`
+	TTL      attributes.Explicit[int] `values:"ttl,omitzero"`
+	Priority attributes.Explicit[int] `values:"priority,omitzero"`
+}
+
+// control performs a control action against the SearchJob. Like Create, it is sent even in dry-run
+// mode, so jobs created in dry-run mode can still be managed.
+func (job SearchJob) control(ctx context.Context, c *client.Client, request controlRequest) error {
+	return c.RequestAndHandleContext(
+		ctx,
+		client.ComposeRequestBuilder(
+			client.BuildRequestMethod(http.MethodPost),
+			client.BuildRequestEntryActionURL(c, job, "control"),
+			client.BuildRequestOutputModeJSON(),
+			client.BuildRequestBodyValues(request),
+			client.BuildRequestDryRunExempt(),
+			client.BuildRequestAuthenticate(c),
+		),
+		client.ComposeResponseHandler(
+			client.HandleResponseCode(http.StatusNotFound, client.HandleResponseJSONMessagesCustomError(client.ErrorNotFound)),
+			client.HandleResponseRequireCode(http.StatusOK, client.HandleResponseJSONMessagesError()),
+		),
+	)
+}
+
+// Cancel stops the SearchJob and deletes its results.
+func (job SearchJob) Cancel(ctx context.Context, c *client.Client) error {
+	return job.control(ctx, c, controlRequest{Action: "cancel"})
+}
+
+// Pause suspends execution of the SearchJob.
+func (job SearchJob) Pause(ctx context.Context, c *client.Client) error {
+	return job.control(ctx, c, controlRequest{Action: "pause"})
+}
+
+// Unpause resumes execution of a paused SearchJob.
+func (job SearchJob) Unpause(ctx context.Context, c *client.Client) error {
+	return job.control(ctx, c, controlRequest{Action: "unpause"})
+}
+
+// Finalize stops the SearchJob, retaining the results it has produced so far.
+func (job SearchJob) Finalize(ctx context.Context, c *client.Client) error {
+	return job.control(ctx, c, controlRequest{Action: "finalize"})
+}
+
+// Touch extends the expiration time of the SearchJob by its current TTL.
+func (job SearchJob) Touch(ctx context.Context, c *client.Client) error {
+	return job.control(ctx, c, controlRequest{Action: "touch"})
+}
+
+// SetTTL sets the SearchJob's TTL, in seconds.
+func (job SearchJob) SetTTL(ctx context.Context, c *client.Client, ttl int) error {
+	return job.control(ctx, c, controlRequest{Action: "setttl", TTL: attributes.NewExplicit(ttl)})
+}
+
+// SetPriority sets the SearchJob's priority, from 0 to 10.
+func (job SearchJob) SetPriority(ctx context.Context, c *client.Client, priority int) error {
+	return job.control(ctx, c, controlRequest{Action: "setpriority", Priority: attributes.NewExplicit(priority)})
+}
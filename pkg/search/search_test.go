@@ -0,0 +1,220 @@
+// Copyright 2022 Splunk, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/splunk/go-splunk-client/pkg/attributes"
+	"github.com/splunk/go-splunk-client/pkg/authenticators"
+	"github.com/splunk/go-splunk-client/pkg/client"
+	"github.com/splunk/go-splunk-client/pkg/internal/checks"
+)
+
+func TestSearchJobOptions_values(t *testing.T) {
+	tests := checks.QueryValuesTestCases{
+		{
+			Name:  "search only",
+			Input: SearchJobOptions{Search: "search index=main"},
+			Want:  url.Values{"search": []string{"search index=main"}},
+		},
+		{
+			Name: "options",
+			Input: SearchJobOptions{
+				Search:       "search index=main",
+				EarliestTime: attributes.NewExplicit("-1h"),
+				LatestTime:   attributes.NewExplicit("now"),
+				ExecMode:     ExecModeBlocking,
+				MaxCount:     attributes.NewExplicit(0),
+			},
+			Want: url.Values{
+				"search":        []string{"search index=main"},
+				"earliest_time": []string{"-1h"},
+				"latest_time":   []string{"now"},
+				"exec_mode":     []string{"blocking"},
+				"max_count":     []string{"0"},
+			},
+		},
+	}
+
+	tests.Test(t)
+}
+
+// testJobServer simulates search/jobs for a single job that becomes done after a number of reads.
+type testJobServer struct {
+	mu          sync.Mutex
+	readsToDone int
+	failed      bool
+	actions     []string
+	queries     []url.Values
+}
+
+func (s *testJobServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body, _ := io.ReadAll(r.Body)
+	form, _ := url.ParseQuery(string(body))
+
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/servicesNS/nobody/search/search/jobs":
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"sid":"1234.5"}`)
+	case r.Method == http.MethodPost && r.URL.Path == "/servicesNS/nobody/search/search/jobs/1234.5/control":
+		s.actions = append(s.actions, form.Get("action"))
+		fmt.Fprint(w, `{"messages":[{"type":"INFO","text":"done"}]}`)
+	case r.URL.Path == "/servicesNS/nobody/search/search/jobs/1234.5":
+		s.readsToDone--
+		isDone := s.readsToDone <= 0
+		fmt.Fprintf(w, `{"entry":[{"id":"https://localhost:8089/servicesNS/nobody/search/search/jobs/1234.5","content":{"sid":"1234.5","isDone":%v,"isFailed":%v,"messages":[{"type":"FATAL","text":"bad search"}]}}]}`, isDone, s.failed)
+	case r.URL.Path == "/servicesNS/nobody/search/search/jobs/1234.5/results":
+		s.queries = append(s.queries, r.URL.Query())
+		if s.readsToDone > 0 {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		fmt.Fprint(w, `{"fields":[{"name":"count"}],"results":[{"count":"42"}]}`)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"messages":[{"type":"ERROR","text":"not found"}]}`)
+	}
+}
+
+func TestSearchJob(t *testing.T) {
+	tests := []struct {
+		name        string
+		inputServer *testJobServer
+		wantErr     error
+		wantResults Results
+		wantQuery   url.Values
+		wantActions []string
+	}{
+		{
+			name:        "done",
+			inputServer: &testJobServer{readsToDone: 3},
+			wantResults: Results{
+				Fields: []string{"count"},
+				Rows:   []Row{{"count": {"42"}}},
+			},
+			wantQuery:   url.Values{"output_mode": []string{"json"}, "count": []string{"0"}},
+			wantActions: []string{"touch", "cancel"},
+		},
+		{
+			name:        "failed",
+			inputServer: &testJobServer{readsToDone: 1, failed: true},
+			wantErr:     ErrJobFailed,
+		},
+	}
+
+	for _, test := range tests {
+		server := httptest.NewServer(test.inputServer)
+		c := &client.Client{URL: server.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}}
+		ctx := context.Background()
+
+		func() {
+			defer server.Close()
+
+			job, err := Create(ctx, c, client.Namespace{User: "nobody", App: "search"}, SearchJobOptions{Search: "| makeresults"})
+			if err != nil {
+				t.Errorf("%s: Create() returned error: %s", test.name, err)
+				return
+			}
+
+			if job.ID.Title != "1234.5" {
+				t.Errorf("%s: Create() got sid %q, want %q", test.name, job.ID.Title, "1234.5")
+			}
+
+			err = job.Wait(ctx, c, time.Millisecond)
+			if !errors.Is(err, test.wantErr) {
+				t.Errorf("%s: Wait() got error %v, want %v", test.name, err, test.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if !job.Content.IsDone {
+				t.Errorf("%s: Wait() returned before job was done", test.name)
+			}
+
+			results, err := job.Results(ctx, c, ResultsOptions{Count: attributes.NewExplicit(0)})
+			if err != nil {
+				t.Errorf("%s: Results() returned error: %s", test.name, err)
+			}
+
+			if !reflect.DeepEqual(results, test.wantResults) {
+				t.Errorf("%s: Results() got\n%#v, want\n%#v", test.name, results, test.wantResults)
+			}
+
+			if !reflect.DeepEqual(test.inputServer.queries, []url.Values{test.wantQuery}) {
+				t.Errorf("%s: Results() got query %v, want %v", test.name, test.inputServer.queries, test.wantQuery)
+			}
+
+			if err := job.Touch(ctx, c); err != nil {
+				t.Errorf("%s: Touch() returned error: %s", test.name, err)
+			}
+
+			if err := job.Cancel(ctx, c); err != nil {
+				t.Errorf("%s: Cancel() returned error: %s", test.name, err)
+			}
+
+			if strings.Join(test.inputServer.actions, ",") != strings.Join(test.wantActions, ",") {
+				t.Errorf("%s: got actions %v, want %v", test.name, test.inputServer.actions, test.wantActions)
+			}
+		}()
+	}
+}
+
+func TestSearchJob_dryRun(t *testing.T) {
+	server := &testJobServer{readsToDone: 1}
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	dryRun := &client.DryRun{}
+	c := &client.Client{URL: httpServer.URL, Authenticator: authenticators.SessionKey{SessionKey: "test"}, DryRun: dryRun}
+	ctx := context.Background()
+
+	job, err := Create(ctx, c, client.Namespace{User: "nobody", App: "search"}, SearchJobOptions{Search: "| makeresults"})
+	if err != nil {
+		t.Fatalf("Create() returned error: %s", err)
+	}
+
+	if job.ID.Title != "1234.5" {
+		t.Errorf("Create() got sid %q, want %q", job.ID.Title, "1234.5")
+	}
+
+	if err := job.Cancel(ctx, c); err != nil {
+		t.Errorf("Cancel() returned error: %s", err)
+	}
+
+	if want := []string{"cancel"}; !reflect.DeepEqual(server.actions, want) {
+		t.Errorf("got actions %v, want %v", server.actions, want)
+	}
+
+	if requests := dryRun.Requests(); len(requests) != 0 {
+		t.Errorf("Create() recorded requests: %#v", requests)
+	}
+}
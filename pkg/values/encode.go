@@ -56,23 +56,34 @@ import (
 // An error is returned if a value's key is an empty string at any level
 // of encoding.
 func Encode(i interface{}) (url.Values, error) {
+	newValues, _, err := EncodeRedacted(i)
+
+	return newValues, err
+}
+
+// EncodeRedacted returns url.Values for a given input interface, as Encode does. It also returns
+// the keys whose values were encoded from a URLValueRedacter that should be redacted, such as
+// attributes.Secret. The returned url.Values contain the actual values for these keys, so that
+// they can be sent, and callers displaying them must mask them.
+func EncodeRedacted(i interface{}) (url.Values, map[string]bool, error) {
 	inputV := reflect.ValueOf(i)
 
 	if !inputV.IsValid() {
-		return nil, fmt.Errorf("values: attempted Encode() on invalid (likely nil) type %T", i)
+		return nil, nil, fmt.Errorf("values: attempted Encode() on invalid (likely nil) type %T", i)
 	}
 
 	newValues := url.Values{}
+	redactedKeys := map[string]bool{}
 
-	if err := encodeValue("", &newValues, inputV); err != nil {
-		return nil, err
+	if err := encodeValue("", &newValues, redactedKeys, inputV); err != nil {
+		return nil, nil, err
 	}
 
-	return newValues, nil
+	return newValues, redactedKeys, nil
 }
 
 // encodeStructValue adds the given input struct to url.Values for a given key.
-func encodeStructValue(key string, inputV reflect.Value, values *url.Values) error {
+func encodeStructValue(key string, inputV reflect.Value, values *url.Values, redactedKeys map[string]bool) error {
 	inputT := inputV.Type()
 	for i := 0; i < inputV.NumField(); i++ {
 		field := inputT.Field(i)
@@ -123,12 +134,12 @@ func encodeStructValue(key string, inputV reflect.Value, values *url.Values) err
 			}
 
 			iV := reflect.New(fieldV.Type().Elem()).Elem()
-			if err := encodeValue(nestedKey, values, iV); err != nil {
+			if err := encodeValue(nestedKey, values, redactedKeys, iV); err != nil {
 				return err
 			}
 		}
 
-		if err := encodeValue(fieldName, values, fieldV); err != nil {
+		if err := encodeValue(fieldName, values, redactedKeys, fieldV); err != nil {
 			return err
 		}
 	}
@@ -136,8 +147,13 @@ func encodeStructValue(key string, inputV reflect.Value, values *url.Values) err
 	return nil
 }
 
-// encodeValue adds the given reflect.Value to url.Values for a given key and tagConfig.
-func encodeValue(key string, values *url.Values, value reflect.Value) error {
+// encodeValue adds the given reflect.Value to url.Values for a given key and tagConfig. The key is
+// added to redactedKeys if the value is a URLValueRedacter that should be redacted.
+func encodeValue(key string, values *url.Values, redactedKeys map[string]bool, value reflect.Value) error {
+	if redacter, ok := value.Interface().(URLValueRedacter); ok && redacter.RedactURLValue() {
+		redactedKeys[key] = true
+	}
+
 	// use full custom encoding if implemented
 	if valuesEncoder, ok := value.Interface().(URLValuesSetter); ok {
 		return valuesEncoder.SetURLValues(key, values)
@@ -145,7 +161,7 @@ func encodeValue(key string, values *url.Values, value reflect.Value) error {
 
 	// use value-only custom encoding if implemented
 	if valueGetter, ok := value.Interface().(URLValueGetter); ok {
-		return encodeValue(key, values, reflect.ValueOf(valueGetter.GetURLValue()))
+		return encodeValue(key, values, redactedKeys, reflect.ValueOf(valueGetter.GetURLValue()))
 	}
 
 	// fully dereference if needed
@@ -158,8 +174,9 @@ func encodeValue(key string, values *url.Values, value reflect.Value) error {
 		// refuse to encode to an empty key.
 		// note this is done only where this function adds to url.Values, as custom encoding should be
 		// trusted to choose the proper key, even if it was empty here.
+		// the value itself isn't included in the error, as it may be a secret.
 		if key == "" {
-			return fmt.Errorf("values: attempted to encode empty key for value %#v", value.Interface())
+			return fmt.Errorf("values: attempted to encode empty key for value of type %T", value.Interface())
 		}
 
 		values.Add(key, fmt.Sprint(value.Interface()))
@@ -172,7 +189,7 @@ func encodeValue(key string, values *url.Values, value reflect.Value) error {
 			}
 
 			iV := value.Index(i)
-			if err := encodeValue(nestedKey, values, iV); err != nil {
+			if err := encodeValue(nestedKey, values, redactedKeys, iV); err != nil {
 				return err
 			}
 		}
@@ -185,13 +202,13 @@ func encodeValue(key string, values *url.Values, value reflect.Value) error {
 			}
 
 			nestedValue := value.MapIndex(keyV)
-			if err := encodeValue(nestedKey, values, nestedValue); err != nil {
+			if err := encodeValue(nestedKey, values, redactedKeys, nestedValue); err != nil {
 				return err
 			}
 		}
 
 	case reflect.Struct:
-		if err := encodeStructValue(key, value, values); err != nil {
+		if err := encodeStructValue(key, value, values, redactedKeys); err != nil {
 			return err
 		}
 
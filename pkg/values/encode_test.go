@@ -72,6 +72,16 @@ func (a testAddValuesStruct) AddURLValues(key string, v *url.Values) error {
 	return nil
 }
 
+type testRedactedString string
+
+func (s testRedactedString) GetURLValue() interface{} {
+	return string(s)
+}
+
+func (s testRedactedString) RedactURLValue() bool {
+	return true
+}
+
 func Test_Encode(t *testing.T) {
 	type StructSliceField []struct {
 		StringField string
@@ -318,3 +328,65 @@ func Test_Encode(t *testing.T) {
 		}
 	}
 }
+
+func Test_EncodeRedacted(t *testing.T) {
+	tests := []struct {
+		name             string
+		input            interface{}
+		wantValues       url.Values
+		wantRedactedKeys map[string]bool
+	}{
+		{
+			name: "no redacted values",
+			input: struct {
+				Name string `values:"name"`
+			}{
+				Name: "test",
+			},
+			wantValues:       url.Values{"name": []string{"test"}},
+			wantRedactedKeys: map[string]bool{},
+		},
+		{
+			name: "redacted values",
+			input: struct {
+				Name     string             `values:"name"`
+				Password testRedactedString `values:"password"`
+				Nested   struct {
+					Token testRedactedString `values:"token"`
+				} `values:"nested"`
+			}{
+				Name:     "test",
+				Password: "changeme",
+				Nested: struct {
+					Token testRedactedString `values:"token"`
+				}{
+					Token: "abc123",
+				},
+			},
+			wantValues: url.Values{
+				"name":         []string{"test"},
+				"password":     []string{"changeme"},
+				"nested.token": []string{"abc123"},
+			},
+			wantRedactedKeys: map[string]bool{
+				"password":     true,
+				"nested.token": true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		gotValues, gotRedactedKeys, err := EncodeRedacted(test.input)
+		if err != nil {
+			t.Errorf("%s: EncodeRedacted() returned error: %s", test.name, err)
+		}
+
+		if !reflect.DeepEqual(gotValues, test.wantValues) {
+			t.Errorf("%s: EncodeRedacted() got values\n%#v, want\n%#v", test.name, gotValues, test.wantValues)
+		}
+
+		if !reflect.DeepEqual(gotRedactedKeys, test.wantRedactedKeys) {
+			t.Errorf("%s: EncodeRedacted() got redacted keys\n%#v, want\n%#v", test.name, gotRedactedKeys, test.wantRedactedKeys)
+		}
+	}
+}
@@ -41,3 +41,10 @@ type URLKeyGetter interface {
 type URLValueGetter interface {
 	GetURLValue() interface{}
 }
+
+// URLValueRedacter is the interface for types whose encoded values are sensitive, such as passwords.
+// They are encoded normally, but EncodeRedacted reports the keys they were encoded to, so that their
+// values can be masked in human-readable output.
+type URLValueRedacter interface {
+	RedactURLValue() bool
+}